@@ -0,0 +1,23 @@
+data "apollostudio_deprecated_fields" "this" {
+  graph_id     = "your-graph-id"
+  variant_name = "your-variant"
+  time_window  = "720h"
+}
+
+locals {
+  used_deprecated_fields = [for field in data.apollostudio_deprecated_fields.this.fields : field.coordinate if field.execution_count > 0]
+}
+
+# Refuse to publish the schema (e.g. the one removing the deprecated fields) while deprecated fields are still requested
+resource "apollostudio_graph_schema" "this" {
+  graph_id     = "your-graph-id"
+  variant_name = "your-variant"
+  schema       = file("${path.module}/schema.graphql")
+
+  lifecycle {
+    precondition {
+      condition     = length(local.used_deprecated_fields) == 0
+      error_message = "Deprecated fields are still in use: ${join(", ", local.used_deprecated_fields)}"
+    }
+  }
+}
@@ -0,0 +1,6 @@
+data "apollostudio_field_usage" "this" {
+  graph_id     = "your-graph-id"
+  variant_name = "your-variant"
+  time_window  = "720h"
+  coordinates  = ["Query.users", "User.email"]
+}
@@ -0,0 +1,16 @@
+data "apollostudio_graph_clients" "this" {
+  graph_id     = "your-graph-id"
+  variant_name = "your-variant"
+  time_window  = "720h"
+}
+
+locals {
+  client_names = toset([for client in data.apollostudio_graph_clients.this.clients : client.name])
+}
+
+# Only exclude clients which actually report to the variant
+resource "apollostudio_graph_check_config" "this" {
+  graph_id = "your-graph-id"
+
+  excluded_clients = [for name in ["legacy-ios", "legacy-android"] : { name = name } if contains(local.client_names, name)]
+}
@@ -1,4 +1,8 @@
 data "apollostudio_graph_variant" "this" {
-  graph_id = "your-graph-id"
-  name     = "your-variant"
+  id = "your-graph-id@your-variant"
+}
+
+# Only roll out the router once the latest launch succeeded
+output "router_ready" {
+  value = data.apollostudio_graph_variant.this.latest_launch_status == "LAUNCH_COMPLETED"
 }
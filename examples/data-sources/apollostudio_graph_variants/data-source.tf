@@ -1,3 +1,9 @@
 data "apollostudio_graph_variants" "this" {
   graph_id = "your-graph-id"
 }
+
+# Preview variants only
+data "apollostudio_graph_variants" "previews" {
+  graph_id   = "your-graph-id"
+  name_regex = "^preview-"
+}
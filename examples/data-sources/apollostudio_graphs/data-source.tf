@@ -1 +1,8 @@
 data "apollostudio_graphs" "this" {}
+
+# Supergraphs owned by the payments team, along with their variants
+data "apollostudio_graphs" "payments" {
+  id_regex         = "^payments-"
+  graph_type       = "CLOUD_SUPERGRAPH"
+  include_variants = true
+}
@@ -0,0 +1 @@
+data "apollostudio_org_api_keys" "this" {}
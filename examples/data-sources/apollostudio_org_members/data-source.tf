@@ -0,0 +1 @@
+data "apollostudio_org_members" "current" {}
@@ -0,0 +1,12 @@
+data "apollostudio_schema_publications" "this" {
+  graph_id     = "your-graph-id"
+  variant_name = "your-variant"
+  limit        = 2
+}
+
+# Changes introduced by the latest publication
+data "apollostudio_schema_diff" "this" {
+  graph_id    = "your-graph-id"
+  base_hash   = data.apollostudio_schema_publications.this.publications[1].hash
+  target_hash = data.apollostudio_schema_publications.this.publications[0].hash
+}
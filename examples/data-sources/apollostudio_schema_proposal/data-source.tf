@@ -0,0 +1,3 @@
+data "apollostudio_schema_proposal" "this" {
+  id = apollostudio_schema_proposal.this.id
+}
@@ -0,0 +1,5 @@
+data "apollostudio_schema_publications" "this" {
+  graph_id     = "your-graph-id"
+  variant_name = "your-variant"
+  limit        = 5
+}
@@ -0,0 +1,3 @@
+data "apollostudio_subgraph" "this" {
+  id = "your-graph-id@your-variant:your-subgraph"
+}
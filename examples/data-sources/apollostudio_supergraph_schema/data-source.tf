@@ -0,0 +1,3 @@
+data "apollostudio_supergraph_schema" "this" {
+  graph_ref = "your-graph-id@your-variant-name"
+}
@@ -0,0 +1 @@
+data "apollostudio_user_api_keys" "this" {}
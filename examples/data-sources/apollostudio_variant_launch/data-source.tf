@@ -0,0 +1,12 @@
+# Latest launch of the variant
+data "apollostudio_variant_launch" "latest" {
+  graph_id     = "your-graph-id"
+  variant_name = "your-variant-name"
+}
+
+# Specific launch of the variant
+data "apollostudio_variant_launch" "this" {
+  graph_id     = "your-graph-id"
+  variant_name = "your-variant-name"
+  id           = "your-launch-id"
+}
@@ -0,0 +1,5 @@
+data "apollostudio_variant_launches" "this" {
+  graph_id     = "your-graph-id"
+  variant_name = "your-variant-name"
+  limit        = 10
+}
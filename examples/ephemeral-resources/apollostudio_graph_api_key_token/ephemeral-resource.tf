@@ -0,0 +1,17 @@
+# Mint a new API key every time the ephemeral resource is opened
+ephemeral "apollostudio_graph_api_key_token" "minted" {
+  graph_id = "your-graph-id"
+  key_name = "your-key-name"
+  role     = "OBSERVER"
+}
+
+# Fetch an API key whose lifecycle is owned by a resource
+resource "apollostudio_graph_api_key" "this" {
+  graph_id = "your-graph-id"
+  key_name = "your-key-name"
+}
+
+ephemeral "apollostudio_graph_api_key_token" "fetched" {
+  graph_id = apollostudio_graph_api_key.this.graph_id
+  id       = apollostudio_graph_api_key.this.id
+}
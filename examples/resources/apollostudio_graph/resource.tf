@@ -2,4 +2,8 @@ resource "apollostudio_graph" "this" {
   id          = "your-graph-id"
   name        = "your-graph-name"
   description = "your-graph-description"
+  graph_type  = "SELF_HOSTED_SUPERGRAPH"
+
+  reporting_enabled             = true
+  hidden_from_uninvited_members = false
 }
@@ -0,0 +1,4 @@
+# Graph API key can be imported using the graph id and either the key id or the key name.
+# Only the masked value of the token is available once imported.
+terraform import apollostudio_graph_api_key.example your-graph-id:your-key-id
+terraform import apollostudio_graph_api_key.example your-graph-id:your-key-name
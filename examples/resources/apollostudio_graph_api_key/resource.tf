@@ -1,4 +1,22 @@
 resource "apollostudio_graph_api_key" "this" {
   graph_id = "your-graph-id"
   key_name = "your-key-name"
+  role     = "CONSUMER"
+}
+
+# API key rotated every 30 days, the previous key is kept alive 10 minutes after its replacement is created
+resource "apollostudio_graph_api_key" "router" {
+  graph_id        = "your-graph-id"
+  key_name        = "router"
+  role            = "CONSUMER"
+  rotation_period = "720h"
+  grace_period    = "10m"
+
+  rotation_triggers = {
+    router_version = "your-router-version"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
 }
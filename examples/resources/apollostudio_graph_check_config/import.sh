@@ -0,0 +1,2 @@
+# Graph check config can be imported using the graph id
+terraform import apollostudio_graph_check_config.example your-graph-id
@@ -0,0 +1,23 @@
+resource "apollostudio_graph_check_config" "this" {
+  graph_id = "your-graph-id"
+
+  time_range_seconds                   = 1209600
+  operation_count_threshold            = 10
+  operation_count_threshold_percentage = 0.5
+  include_base_variant                 = true
+  included_variants                    = ["staging"]
+  downstream_variants_to_check         = ["public"]
+  proposal_change_mismatch_severity    = "WARN"
+
+  excluded_clients = [
+    {
+      name = "internal-tools"
+    },
+    {
+      name    = "ios"
+      version = "1.0.0"
+    },
+  ]
+
+  excluded_operation_names = ["IntrospectionQuery"]
+}
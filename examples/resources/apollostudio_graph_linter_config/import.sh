@@ -0,0 +1,2 @@
+# Graph linter config can be imported using the graph id
+terraform import apollostudio_graph_linter_config.example your-graph-id
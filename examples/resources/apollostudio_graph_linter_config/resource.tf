@@ -0,0 +1,13 @@
+resource "apollostudio_graph_linter_config" "this" {
+  graph_id = "your-graph-id"
+
+  rules = {
+    FIELD_NAMES_SHOULD_BE_CAMEL_CASE     = "ERROR"
+    TYPE_NAMES_SHOULD_BE_PASCAL_CASE     = "ERROR"
+    DEPRECATED_DIRECTIVE_REQUIRES_REASON = "WARNING"
+    TYPE_PREFIX                          = "IGNORED"
+  }
+
+  ignored_directives         = ["internal"]
+  ignore_existing_violations = true
+}
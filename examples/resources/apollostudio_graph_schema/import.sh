@@ -0,0 +1,2 @@
+# Graph schema can be imported using the graph id and the variant name
+terraform import apollostudio_graph_schema.example your-graph-id@variant-name
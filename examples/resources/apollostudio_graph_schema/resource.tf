@@ -0,0 +1,5 @@
+resource "apollostudio_graph_schema" "this" {
+  graph_id     = "your-graph-id"
+  variant_name = "your-variant-name"
+  schema       = file("${path.module}/schema.graphql")
+}
@@ -0,0 +1,2 @@
+# Notification channel can be imported using the graph id and the channel id
+terraform import apollostudio_notification_channel.example your-graph-id:your-channel-id
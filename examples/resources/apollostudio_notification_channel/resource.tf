@@ -0,0 +1,21 @@
+resource "apollostudio_notification_channel" "webhook" {
+  graph_id     = "your-graph-id"
+  type         = "WEBHOOK"
+  name         = "schema-changes"
+  url          = "https://example.com/apollo/notifications"
+  secret_token = var.webhook_secret_token
+}
+
+resource "apollostudio_notification_channel" "slack" {
+  graph_id = "your-graph-id"
+  type     = "SLACK"
+  name     = "#graph-alerts"
+  url      = var.slack_webhook_url
+}
+
+resource "apollostudio_notification_channel" "email" {
+  graph_id = "your-graph-id"
+  type     = "EMAIL"
+  name     = "on-call"
+  email    = "oncall@example.com"
+}
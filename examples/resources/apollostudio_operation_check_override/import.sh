@@ -0,0 +1,5 @@
+# Ignored operations can be imported using the graph id, IGNORE and the operation id
+terraform import apollostudio_operation_check_override.example your-graph-id:IGNORE:your-operation-id
+
+# Changes marked as safe can be imported using the graph id, MARK_SAFE, the check id and the operation id
+terraform import apollostudio_operation_check_override.example your-graph-id:MARK_SAFE:your-check-id:your-operation-id
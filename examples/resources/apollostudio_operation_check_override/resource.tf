@@ -0,0 +1,14 @@
+# Approve the changes found by a check for an operation
+resource "apollostudio_operation_check_override" "safe" {
+  graph_id     = "your-graph-id"
+  operation_id = "your-operation-id"
+  action       = "MARK_SAFE"
+  check_id     = "your-check-id"
+}
+
+# Ignore an operation in future checks
+resource "apollostudio_operation_check_override" "ignored" {
+  graph_id     = "your-graph-id"
+  operation_id = "your-other-operation-id"
+  action       = "IGNORE"
+}
@@ -0,0 +1,17 @@
+resource "apollostudio_org_api_key" "this" {
+  key_name = "your-key-name"
+  role     = "OBSERVER"
+}
+
+# API key used by the provider itself, rotated every 90 days. The previous key is kept alive 10 minutes after its
+# replacement is created, store the new token where APOLLO_KEY is read from before it expires
+resource "apollostudio_org_api_key" "terraform" {
+  key_name        = "terraform"
+  role            = "ORG_ADMIN"
+  rotation_period = "2160h"
+  grace_period    = "10m"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
@@ -0,0 +1,2 @@
+# Organization invitation can be imported using the invitation id
+terraform import apollostudio_org_invitation.example your-invitation-id
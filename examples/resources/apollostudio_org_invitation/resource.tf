@@ -0,0 +1,4 @@
+resource "apollostudio_org_invitation" "example" {
+  email = "jane.doe@example.com"
+  role  = "CONTRIBUTOR"
+}
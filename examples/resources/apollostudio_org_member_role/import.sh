@@ -0,0 +1,2 @@
+# Organization member role can be imported using the user id
+terraform import apollostudio_org_member_role.example your-user-id
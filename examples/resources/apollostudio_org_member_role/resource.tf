@@ -0,0 +1,11 @@
+data "apollostudio_org_members" "current" {}
+
+locals {
+  members = { for member in data.apollostudio_org_members.current.members : member.email => member.id }
+}
+
+# Destroying this resource removes the user from the organization
+resource "apollostudio_org_member_role" "example" {
+  user_id = local.members["jane.doe@example.com"]
+  role    = "GRAPH_ADMIN"
+}
@@ -0,0 +1,2 @@
+# Performance alert can be imported using the graph id and the alert id
+terraform import apollostudio_performance_alert.example your-graph-id:your-alert-id
@@ -0,0 +1,27 @@
+resource "apollostudio_notification_channel" "slack" {
+  graph_id = "your-graph-id"
+  type     = "SLACK"
+  name     = "#graph-alerts"
+  url      = var.slack_webhook_url
+}
+
+resource "apollostudio_performance_alert" "error_rate" {
+  graph_id     = "your-graph-id"
+  variant_name = "your-variant-name"
+  metric       = "ERROR_PERCENTAGE"
+  threshold    = 5
+  window       = "FIVE_MINUTES"
+  scope        = "ANY"
+  channel_ids  = [apollostudio_notification_channel.slack.id]
+}
+
+resource "apollostudio_performance_alert" "checkout_latency" {
+  graph_id        = "your-graph-id"
+  variant_name    = "your-variant-name"
+  metric          = "P95_DURATION"
+  threshold       = 500
+  window          = "FIFTEEN_MINUTES"
+  scope           = "INCLUDED"
+  operation_names = ["Checkout"]
+  channel_ids     = [apollostudio_notification_channel.slack.id]
+}
@@ -0,0 +1,2 @@
+# Persisted query list can be imported using the graph id and the list id
+terraform import apollostudio_persisted_query_list.example your-graph-id:your-list-id
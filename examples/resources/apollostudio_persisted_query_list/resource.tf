@@ -0,0 +1,6 @@
+resource "apollostudio_persisted_query_list" "this" {
+  graph_id        = "your-graph-id"
+  name            = "your-list-name"
+  description     = "your-list-description"
+  linked_variants = ["your-variant-name"]
+}
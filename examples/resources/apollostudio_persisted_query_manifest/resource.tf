@@ -0,0 +1,11 @@
+resource "apollostudio_persisted_query_list" "this" {
+  graph_id        = "your-graph-id"
+  name            = "your-list-name"
+  linked_variants = ["your-variant-name"]
+}
+
+resource "apollostudio_persisted_query_manifest" "this" {
+  graph_id = apollostudio_persisted_query_list.this.graph_id
+  list_id  = apollostudio_persisted_query_list.this.id
+  manifest = file("${path.module}/persisted-query-manifest.json")
+}
@@ -0,0 +1,2 @@
+# Schema change subscription can be imported using the graph id and the subscription id
+terraform import apollostudio_schema_change_subscription.example your-graph-id:your-subscription-id
@@ -0,0 +1,13 @@
+resource "apollostudio_notification_channel" "slack" {
+  graph_id = "your-graph-id"
+  type     = "SLACK"
+  name     = "#graph-alerts"
+  url      = var.slack_webhook_url
+}
+
+resource "apollostudio_schema_change_subscription" "this" {
+  graph_id     = "your-graph-id"
+  variant_name = "your-variant-name"
+  channel_ids  = [apollostudio_notification_channel.slack.id]
+  events       = ["BUILD_ERROR", "LAUNCH_FAILURE"]
+}
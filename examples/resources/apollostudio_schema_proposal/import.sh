@@ -0,0 +1,2 @@
+# Schema proposal can be imported using the graph id and the proposal id
+terraform import apollostudio_schema_proposal.example your-graph-id:your-proposal-id
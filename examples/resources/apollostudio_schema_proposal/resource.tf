@@ -0,0 +1,10 @@
+resource "apollostudio_schema_proposal" "this" {
+  graph_id       = "your-graph-id"
+  source_variant = "your-variant-name"
+  title          = "Add the reviews field to products"
+  description    = "Expose product reviews to the storefront"
+
+  subgraphs = {
+    products = file("${path.module}/products.graphql")
+  }
+}
@@ -0,0 +1,4 @@
+# User API key can be imported using either the key id or the key name.
+# Only the masked value of the token is available once imported.
+terraform import apollostudio_user_api_key.example your-key-id
+terraform import apollostudio_user_api_key.example your-key-name
@@ -0,0 +1,10 @@
+# The provider must be configured with a personal API key, the new key belongs to the same user
+resource "apollostudio_user_api_key" "this" {
+  key_name        = "your-key-name"
+  rotation_period = "720h"
+  grace_period    = "5m"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
@@ -0,0 +1,2 @@
+# Variant build config can be imported using the graph id and the variant name
+terraform import apollostudio_variant_build_config.example your-graph-id@variant-name
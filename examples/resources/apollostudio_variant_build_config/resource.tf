@@ -0,0 +1,5 @@
+resource "apollostudio_variant_build_config" "this" {
+  graph_id           = "your-graph-id"
+  variant_name       = "your-variant-name"
+  federation_version = "2.3"
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+// apiKey is an API key of a graph, an organization or a user. User API keys have no role.
+type apiKey struct {
+	Id        string
+	KeyName   string
+	Role      string
+	Token     string
+	CreatedAt string
+}
+
+// ApiKeyResourceModel holds the attributes shared by the API key resources.
+type ApiKeyResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	KeyName   types.String `tfsdk:"key_name"`
+	Token     types.String `tfsdk:"token"`
+	CreatedAt types.String `tfsdk:"created_at"`
+
+	RotationTriggers types.Map    `tfsdk:"rotation_triggers"`
+	RotationPeriod   types.String `tfsdk:"rotation_period"`
+	GracePeriod      types.String `tfsdk:"grace_period"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+}
+
+func (m *ApiKeyResourceModel) apiKeyModel() *ApiKeyResourceModel {
+	return m
+}
+
+// refresh copies the attributes of the API key that can be read back, the token is only returned on creation.
+func (m *ApiKeyResourceModel) refresh(key apiKey) {
+	m.Id = types.StringValue(key.Id)
+	m.KeyName = types.StringValue(key.KeyName)
+	m.CreatedAt = types.StringValue(key.CreatedAt)
+}
+
+// apiKeyResourceModel is implemented by the models of the API key resources, embedding ApiKeyResourceModel.
+type apiKeyResourceModel interface {
+	apiKeyModel() *ApiKeyResourceModel
+	refresh(key apiKey)
+}
+
+// apiKeyOwner is implemented by each API key resource to reach the API keys of its graph, organization or user.
+type apiKeyOwner interface {
+	newApiKeyModel() apiKeyResourceModel
+	// importApiKeyModel returns the model of the owner found in an import ID, along with the ID or name of the API key
+	importApiKeyModel(ctx context.Context, id string) (apiKeyResourceModel, string, diag.Diagnostics)
+	getApiKeys(ctx context.Context, model apiKeyResourceModel) ([]apiKey, error)
+	renameApiKey(ctx context.Context, model apiKeyResourceModel, apiKeyId string, keyName string) error
+	removeApiKey(ctx context.Context, model apiKeyResourceModel, apiKeyId string) error
+}
+
+// apiKeyResource implements the parts of the API key resources that don't depend on the owner of the API key.
+type apiKeyResource struct {
+	client *client.ApolloClient
+	owner  apiKeyOwner
+	// kind names the API key in messages, e.g. "graph api key"
+	kind string
+}
+
+func (r *apiKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan rotates the API key once its rotation period is over.
+func (r *apiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planApiKeyRotation(ctx, req, resp, r.kind)
+}
+
+func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	state := r.owner.newApiKeyModel()
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed api key from Apollo Studio
+	apiKeys, err := r.owner.getApiKeys(ctx, state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get "+r.kind,
+			fmt.Sprintf("Failed to get %s: %s", r.kind, err.Error()),
+		)
+		return
+	}
+
+	var found bool
+	for _, key := range apiKeys {
+		if key.Id == state.apiKeyModel().Id.ValueString() {
+			// Override state with refreshed values
+			state.refresh(key)
+			found = true
+		}
+	}
+
+	// If not found
+	if !found {
+		resp.Diagnostics.AddError(
+			"Failed to get "+r.kind,
+			fmt.Sprintf("Failed to get %s: %s because the API key wasn't found. Might have been deleted", r.kind, state.apiKeyModel().Id.ValueString()),
+		)
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Return values from plan
+	plan := r.owner.newApiKeyModel()
+	diags := req.Plan.Get(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	state := r.owner.newApiKeyModel()
+	diags = req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the API key, the owner and the role require a replacement
+	planKey, stateKey := plan.apiKeyModel(), state.apiKeyModel()
+	if planKey.KeyName.ValueString() != stateKey.KeyName.ValueString() {
+		err := r.owner.renameApiKey(ctx, state, stateKey.Id.ValueString(), planKey.KeyName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating "+r.kind,
+				"Could not update "+r.kind+" "+stateKey.Id.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response body to schema and populate response
+	planKey.Id = stateKey.Id
+	planKey.Token = stateKey.Token
+	planKey.CreatedAt = stateKey.CreatedAt
+
+	// Start a new rotation period when one was added
+	expiresAt, err := updatedApiKeyExpiresAt(stateKey.ExpiresAt, stateKey.RotationPeriod, planKey.RotationPeriod)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating "+r.kind,
+			"Could not parse rotation period, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	planKey.ExpiresAt = expiresAt
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	state := r.owner.newApiKeyModel()
+	diags := req.State.Get(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the API key alive while consumers switch to its replacement
+	stateKey := state.apiKeyModel()
+	err := waitApiKeyGracePeriod(ctx, stateKey.GracePeriod, r.kind+" "+stateKey.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete "+r.kind,
+			"Failed to delete "+r.kind+" "+stateKey.Id.ValueString()+", "+err.Error(),
+		)
+		return
+	}
+
+	// Delete the API key
+	err = r.owner.removeApiKey(ctx, state, stateKey.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete "+r.kind,
+			"Failed to delete "+r.kind+" "+stateKey.Id.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Import %s: %s", r.kind, req.ID))
+	state, keyRef, diags := r.owner.importApiKeyModel(ctx, req.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKeys, err := r.owner.getApiKeys(ctx, state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get "+r.kind,
+			fmt.Sprintf("Failed to get %s: %s", r.kind, err.Error()),
+		)
+		return
+	}
+
+	// Look up by ID first, then by name as long as it's not ambiguous
+	var matches []apiKey
+	for _, key := range apiKeys {
+		if key.Id == keyRef {
+			matches = []apiKey{key}
+			break
+		}
+		if key.KeyName == keyRef {
+			matches = append(matches, key)
+		}
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Failed to get "+r.kind,
+			fmt.Sprintf("Failed to get %s: %s because the API key wasn't found.", r.kind, req.ID),
+		)
+		return
+	}
+
+	if len(matches) > 1 {
+		resp.Diagnostics.AddError(
+			"Failed to get "+r.kind,
+			fmt.Sprintf("Failed to get %s: %d API keys are named %s, import it using its ID instead.", r.kind, len(matches), keyRef),
+		)
+		return
+	}
+
+	// The token can't be read back, only its masked value is available
+	state.refresh(matches[0])
+	stateKey := state.apiKeyModel()
+	stateKey.Token = types.StringValue(matches[0].Token)
+	stateKey.RotationTriggers = types.MapNull(types.StringType)
+	stateKey.RotationPeriod = types.StringNull()
+	stateKey.GracePeriod = types.StringNull()
+	stateKey.ExpiresAt = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
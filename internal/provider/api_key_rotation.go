@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// durationRegex matches the duration strings understood by time.ParseDuration (e.g. `720h`, `1h30m`).
+var durationRegex = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+
+// maxApiKeyGracePeriod caps the grace period so a rotation can't block an apply for too long.
+const maxApiKeyGracePeriod = 30 * time.Minute
+
+var _ validator.String = durationAtMostValidator{}
+
+// durationAtMostValidator validates that a duration string doesn't exceed a maximum duration.
+type durationAtMostValidator struct {
+	max time.Duration
+}
+
+func (v durationAtMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("must be a duration of at most %s", v.max)
+}
+
+func (v durationAtMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationAtMostValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// Malformed durations are reported by the format validator
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		return
+	}
+
+	if duration > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// withApiKeyRotationAttributes adds the attributes rotating an API key to the attributes of an API key resource.
+func withApiKeyRotationAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes["rotation_triggers"] = schema.MapAttribute{
+		Description: "Arbitrary map of values that, when changed, rotates the API key. Use it with `create_before_destroy` so the new key exists before the previous one is deleted",
+		Optional:    true,
+		ElementType: types.StringType,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["rotation_period"] = schema.StringAttribute{
+		Description: "Duration after which the API key is rotated on the next apply (e.g. `720h`). Changing the period rotates the API key, adding or removing it doesn't. Use it with `create_before_destroy` so the new key exists before the previous one is deleted",
+		Optional:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplaceIf(
+				func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.IsNull()
+				},
+				"Changing the rotation period rotates the API key, adding or removing it doesn't.",
+				"Changing the rotation period rotates the API key, adding or removing it doesn't.",
+			),
+		},
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(durationRegex, "must be a duration like 720h or 1h30m"),
+		},
+	}
+	attributes["grace_period"] = schema.StringAttribute{
+		Description: "Duration during which the API key is kept alive before being deleted, at most `30m` (e.g. `5m`). Setting it opts in to waiting: the apply waits for the grace period to end every time the API key is deleted, on a `create_before_destroy` rotation as well as on destroy",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(durationRegex, "must be a duration like 5m or 1h30m"),
+			durationAtMostValidator{max: maxApiKeyGracePeriod},
+		},
+	}
+	attributes["expires_at"] = schema.StringAttribute{
+		Description: "Date after which the API key is rotated, set when `rotation_period` is",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	return attributes
+}
+
+// planApiKeyRotation replaces the API key once the expiration date stored in state is over, and plans a new
+// expiration date when the rotation period is added or removed.
+func planApiKeyRotation(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, kind string) {
+	// Nothing to rotate on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stateRotationPeriod, planRotationPeriod types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotation_period"), &stateRotationPeriod)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotation_period"), &planRotationPeriod)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planRotationPeriod.Equal(stateRotationPeriod) {
+		expiresAt := types.StringUnknown()
+		if planRotationPeriod.IsNull() {
+			expiresAt = types.StringNull()
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), expiresAt)...)
+		return
+	}
+
+	var expiresAt types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+	if resp.Diagnostics.HasError() || expiresAt.IsNull() {
+		return
+	}
+
+	expirationDate, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("expires_at"),
+			fmt.Sprintf("Invalid %s expiration date", kind),
+			fmt.Sprintf("Could not parse expiration date %s, the API key won't be rotated: %s", expiresAt.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if time.Now().Before(expirationDate) {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("%s expired at %s, planning its rotation", kind, expiresAt.ValueString()))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+}
+
+// apiKeyExpiresAt returns when an API key created now must be rotated, null without rotation period.
+func apiKeyExpiresAt(rotationPeriod types.String) (types.String, error) {
+	if rotationPeriod.IsNull() {
+		return types.StringNull(), nil
+	}
+	duration, err := time.ParseDuration(rotationPeriod.ValueString())
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(time.Now().UTC().Add(duration).Format(time.RFC3339)), nil
+}
+
+// updatedApiKeyExpiresAt returns the expiration date of an updated API key, starting a new rotation period
+// from now when the period was added.
+func updatedApiKeyExpiresAt(state types.String, stateRotationPeriod types.String, planRotationPeriod types.String) (types.String, error) {
+	if planRotationPeriod.Equal(stateRotationPeriod) {
+		return state, nil
+	}
+	return apiKeyExpiresAt(planRotationPeriod)
+}
+
+// waitApiKeyGracePeriod waits for the grace period of an API key to end before it gets deleted. Terraform doesn't
+// tell a rotation from a destroy, so setting a grace period opts in to waiting on every deletion.
+func waitApiKeyGracePeriod(ctx context.Context, gracePeriod types.String, name string) error {
+	if gracePeriod.IsNull() {
+		return nil
+	}
+
+	duration, err := time.ParseDuration(gracePeriod.ValueString())
+	if err != nil {
+		return fmt.Errorf("could not parse grace period: %w", err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Waiting %s before deleting %s", duration, name))
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("interrupted while waiting for the grace period to end: %w", ctx.Err())
+	case <-time.After(duration):
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+// addCheckWorkflowDiagnostics reports the results of a check workflow as a single warning and a single error
+// diagnostic, subject names what was validated (e.g. `subgraph schema`).
+func addCheckWorkflowDiagnostics(diags *diag.Diagnostics, subject string, validationResults []client.WorkflowCheckTaskResult) {
+	// Prepare errors and warnings to be shown in output
+	var validationErrorStrBuilder strings.Builder
+	var validationWarningStrBuilder strings.Builder
+
+	for _, result := range validationResults {
+		for _, detail := range result.Details {
+			message := fmt.Sprintf("%s : %s\n", result.TaskName, detail.Message)
+			if detail.Level == client.LogLevelError {
+				validationErrorStrBuilder.WriteString(message)
+			} else {
+				validationWarningStrBuilder.WriteString(message)
+			}
+		}
+	}
+
+	validationErrorStr := strings.TrimSpace(validationErrorStrBuilder.String())
+	validationWarningStr := strings.TrimSpace(validationWarningStrBuilder.String())
+
+	if validationWarningStr != "" {
+		diags.AddWarning(
+			fmt.Sprintf("Warnings while validating %s", subject),
+			fmt.Sprintf("Warnings while validating %s:\n\n%s", subject, validationWarningStr),
+		)
+	}
+
+	if validationErrorStr != "" {
+		diags.AddError(
+			fmt.Sprintf("Failed to validate %s", subject),
+			fmt.Sprintf("Failed to validate %s:\n\n%s", subject, validationErrorStr),
+		)
+	}
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var _ datasource.DataSource = &DeprecatedFieldsDataSource{}
+
+type DeprecatedFieldsDataSource struct {
+	client *client.ApolloClient
+}
+
+type DeprecatedFieldModel struct {
+	Coordinate                types.String            `tfsdk:"coordinate"`
+	ParentType                types.String            `tfsdk:"parent_type"`
+	FieldName                 types.String            `tfsdk:"field_name"`
+	DeprecationReason         types.String            `tfsdk:"deprecation_reason"`
+	ExecutionCount            types.Int64             `tfsdk:"execution_count"`
+	ReferencingOperationCount types.Int64             `tfsdk:"referencing_operation_count"`
+	Clients                   []FieldUsageClientModel `tfsdk:"clients"`
+}
+
+type DeprecatedFieldsDataSourceModel struct {
+	GraphId     types.String           `tfsdk:"graph_id"`
+	VariantName types.String           `tfsdk:"variant_name"`
+	TimeWindow  types.String           `tfsdk:"time_window"`
+	Fields      []DeprecatedFieldModel `tfsdk:"fields"`
+}
+
+func NewDeprecatedFieldsDataSource() datasource.DataSource {
+	return &DeprecatedFieldsDataSource{}
+}
+
+func (d *DeprecatedFieldsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deprecated_fields"
+}
+
+func (d *DeprecatedFieldsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provide the fields and enum values marked as `@deprecated` in the latest schema of a specific graph variant, along with their usage. Deprecated arguments and input fields aren't listed",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+			},
+			"variant_name": schema.StringAttribute{
+				Description: "Name of the variant",
+				Required:    true,
+			},
+			"time_window": timeWindowAttribute,
+			"fields": schema.ListNestedAttribute{
+				Description: "List of deprecated fields and enum values, sorted by coordinate",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"coordinate": schema.StringAttribute{
+							Description: "Coordinate of the field, i.e. `<parent-type>.<field-name>`, or of the enum value, i.e. `<enum-type>.<value>`",
+							Computed:    true,
+						},
+						"parent_type": schema.StringAttribute{
+							Description: "Name of the type the field or the enum value belongs to",
+							Computed:    true,
+						},
+						"field_name": schema.StringAttribute{
+							Description: "Name of the field or of the enum value",
+							Computed:    true,
+						},
+						"deprecation_reason": schema.StringAttribute{
+							Description: "Reason given in the `@deprecated` directive",
+							Computed:    true,
+						},
+						"execution_count": schema.Int64Attribute{
+							Description: "Estimated number of times the field was requested, 0 when it's unused",
+							Computed:    true,
+						},
+						"referencing_operation_count": schema.Int64Attribute{
+							Description: "Number of operations referencing the field, summed over the clients",
+							Computed:    true,
+						},
+						"clients": fieldUsageClientsAttribute,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeprecatedFieldsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeprecatedFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DeprecatedFieldsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deprecatedFields, err := d.client.GetDeprecatedFields(ctx, data.GraphId.ValueString(), data.VariantName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get deprecated fields",
+			fmt.Sprintf("Failed to get deprecated fields: %s", err.Error()),
+		)
+		return
+	}
+
+	from, to, err := statsWindow(data.TimeWindow)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get field usage",
+			fmt.Sprintf("Failed to get field usage: could not parse time window: %s", err.Error()),
+		)
+		return
+	}
+
+	usages, err := d.client.GetFieldUsage(ctx, data.GraphId.ValueString(), data.VariantName.ValueString(), from, to)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get field usage",
+			fmt.Sprintf("Failed to get field usage: %s", err.Error()),
+		)
+		return
+	}
+
+	totals := aggregateFieldUsage(usages)
+
+	data.Fields = make([]DeprecatedFieldModel, 0, len(deprecatedFields))
+	for _, deprecatedField := range deprecatedFields {
+		coordinate := deprecatedField.ParentType + "." + deprecatedField.FieldName
+		field := DeprecatedFieldModel{
+			Coordinate:                types.StringValue(coordinate),
+			ParentType:                types.StringValue(deprecatedField.ParentType),
+			FieldName:                 types.StringValue(deprecatedField.FieldName),
+			DeprecationReason:         types.StringValue(deprecatedField.DeprecationReason),
+			ExecutionCount:            types.Int64Value(0),
+			ReferencingOperationCount: types.Int64Value(0),
+			Clients:                   make([]FieldUsageClientModel, 0),
+		}
+		if total, ok := totals[coordinate]; ok {
+			field.ExecutionCount = types.Int64Value(total.executionCount)
+			field.ReferencingOperationCount = types.Int64Value(total.referencingOperationCount)
+			field.Clients = total.clients
+		}
+		data.Fields = append(data.Fields, field)
+	}
+	sort.Slice(data.Fields, func(i, j int) bool {
+		return data.Fields[i].Coordinate.ValueString() < data.Fields[j].Coordinate.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
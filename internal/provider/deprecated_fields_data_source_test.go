@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeprecatedFieldsDataSource(t *testing.T) {
+	randomId := uuid.New().String()[0:8]
+	graphId := fmt.Sprintf("test-%s", randomId)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_graph" "this" {
+					id = "` + graphId + `"
+					name = "` + graphId + `"
+					description = "Test Graph"
+					graph_type = "CLASSIC"
+				}
+
+				resource "apollostudio_graph_schema" "this" {
+					graph_id = apollostudio_graph.this.id
+					variant_name = "current"
+					schema = "type Query { hello: String, old: String @deprecated(reason: \"Use hello\"), color: Color } enum Color { RED GREEN @deprecated(reason: \"Use RED\") }"
+				}
+
+				data "apollostudio_deprecated_fields" "this" {
+					graph_id     = apollostudio_graph_schema.this.graph_id
+					variant_name = apollostudio_graph_schema.this.variant_name
+					time_window  = "24h"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.apollostudio_deprecated_fields.this", "fields.#", "2"),
+					resource.TestCheckResourceAttr("data.apollostudio_deprecated_fields.this", "fields.0.coordinate", "Color.GREEN"),
+					resource.TestCheckResourceAttr("data.apollostudio_deprecated_fields.this", "fields.0.deprecation_reason", "Use RED"),
+					resource.TestCheckResourceAttr("data.apollostudio_deprecated_fields.this", "fields.1.coordinate", "Query.old"),
+					resource.TestCheckResourceAttr("data.apollostudio_deprecated_fields.this", "fields.1.parent_type", "Query"),
+					resource.TestCheckResourceAttr("data.apollostudio_deprecated_fields.this", "fields.1.field_name", "old"),
+					resource.TestCheckResourceAttr("data.apollostudio_deprecated_fields.this", "fields.1.deprecation_reason", "Use hello"),
+					resource.TestCheckResourceAttr("data.apollostudio_deprecated_fields.this", "fields.1.execution_count", "0"),
+					resource.TestCheckResourceAttr("data.apollostudio_deprecated_fields.this", "fields.1.clients.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var _ datasource.DataSource = &FieldUsageDataSource{}
+
+type FieldUsageDataSource struct {
+	client *client.ApolloClient
+}
+
+type FieldUsageModel struct {
+	Coordinate                types.String            `tfsdk:"coordinate"`
+	ParentType                types.String            `tfsdk:"parent_type"`
+	FieldName                 types.String            `tfsdk:"field_name"`
+	ExecutionCount            types.Int64             `tfsdk:"execution_count"`
+	ReferencingOperationCount types.Int64             `tfsdk:"referencing_operation_count"`
+	Clients                   []FieldUsageClientModel `tfsdk:"clients"`
+}
+
+type FieldUsageDataSourceModel struct {
+	GraphId     types.String      `tfsdk:"graph_id"`
+	VariantName types.String      `tfsdk:"variant_name"`
+	TimeWindow  types.String      `tfsdk:"time_window"`
+	Coordinates []types.String    `tfsdk:"coordinates"`
+	Fields      []FieldUsageModel `tfsdk:"fields"`
+}
+
+func NewFieldUsageDataSource() datasource.DataSource {
+	return &FieldUsageDataSource{}
+}
+
+func (d *FieldUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_field_usage"
+}
+
+func (d *FieldUsageDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provide the usage of the fields of a specific graph variant and the clients requesting them. Fields that weren't requested during the period aren't listed",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+			},
+			"variant_name": schema.StringAttribute{
+				Description: "Name of the variant",
+				Required:    true,
+			},
+			"time_window": timeWindowAttribute,
+			"coordinates": schema.SetAttribute{
+				Description: "Coordinates of the fields to return (e.g. `Query.users`), all the requested fields are returned when not set",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"fields": schema.ListNestedAttribute{
+				Description: "List of fields, sorted by coordinate",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"coordinate": schema.StringAttribute{
+							Description: "Coordinate of the field, i.e. `<parent-type>.<field-name>`",
+							Computed:    true,
+						},
+						"parent_type": schema.StringAttribute{
+							Description: "Name of the type the field belongs to",
+							Computed:    true,
+						},
+						"field_name": schema.StringAttribute{
+							Description: "Name of the field",
+							Computed:    true,
+						},
+						"execution_count": schema.Int64Attribute{
+							Description: "Estimated number of times the field was requested",
+							Computed:    true,
+						},
+						"referencing_operation_count": schema.Int64Attribute{
+							Description: "Number of operations referencing the field, summed over the clients",
+							Computed:    true,
+						},
+						"clients": fieldUsageClientsAttribute,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FieldUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FieldUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FieldUsageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from, to, err := statsWindow(data.TimeWindow)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get field usage",
+			fmt.Sprintf("Failed to get field usage: could not parse time window: %s", err.Error()),
+		)
+		return
+	}
+
+	usages, err := d.client.GetFieldUsage(ctx, data.GraphId.ValueString(), data.VariantName.ValueString(), from, to)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get field usage",
+			fmt.Sprintf("Failed to get field usage: %s", err.Error()),
+		)
+		return
+	}
+
+	totals := aggregateFieldUsage(usages)
+
+	// Only keep the requested fields
+	coordinates := make([]string, 0, len(totals))
+	if data.Coordinates == nil {
+		for coordinate := range totals {
+			coordinates = append(coordinates, coordinate)
+		}
+	} else {
+		for _, coordinate := range data.Coordinates {
+			if _, ok := totals[coordinate.ValueString()]; ok {
+				coordinates = append(coordinates, coordinate.ValueString())
+			}
+		}
+	}
+	sort.Strings(coordinates)
+
+	data.Fields = make([]FieldUsageModel, 0, len(coordinates))
+	for _, coordinate := range coordinates {
+		total := totals[coordinate]
+		data.Fields = append(data.Fields, FieldUsageModel{
+			Coordinate:                types.StringValue(coordinate),
+			ParentType:                types.StringValue(total.parentType),
+			FieldName:                 types.StringValue(total.fieldName),
+			ExecutionCount:            types.Int64Value(total.executionCount),
+			ReferencingOperationCount: types.Int64Value(total.referencingOperationCount),
+			Clients:                   total.clients,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFieldUsageDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Coordinates without usage are left out
+			{
+				Config: providerConfig + `
+					data "apollostudio_field_usage" "this" {
+						graph_id     = "testacc-terraform"
+						variant_name = "current"
+						time_window  = "720h"
+						coordinates  = ["Query.countries", "Query.unknown"]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.apollostudio_field_usage.this", "fields.#", "1"),
+					resource.TestCheckResourceAttr("data.apollostudio_field_usage.this", "fields.0.coordinate", "Query.countries"),
+					resource.TestCheckResourceAttr("data.apollostudio_field_usage.this", "fields.0.parent_type", "Query"),
+					resource.TestCheckResourceAttr("data.apollostudio_field_usage.this", "fields.0.field_name", "countries"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_field_usage.this", "fields.0.execution_count"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_field_usage.this", "fields.0.clients.#"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_field_usage.this", "fields.0.clients.0.execution_count"),
+				),
+			},
+			// Unknown coordinates are ignored
+			{
+				Config: providerConfig + `
+					data "apollostudio_field_usage" "this" {
+						graph_id     = "testacc-terraform"
+						variant_name = "current"
+						time_window  = "24h"
+						coordinates  = ["Query.unknown"]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.apollostudio_field_usage.this", "fields.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,25 +19,31 @@ import (
 )
 
 var (
-	_ resource.Resource              = &GraphApiKeyResource{}
-	_ resource.ResourceWithConfigure = &GraphApiKeyResource{}
+	_ resource.Resource                = &GraphApiKeyResource{}
+	_ resource.ResourceWithConfigure   = &GraphApiKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &GraphApiKeyResource{}
+	_ resource.ResourceWithImportState = &GraphApiKeyResource{}
 )
 
 type GraphApiKeyResource struct {
-	client *client.ApolloClient
+	apiKeyResource
 }
 
 type GraphApiKeyResourceModel struct {
-	GraphId   types.String `tfsdk:"graph_id"`
-	Id        types.String `tfsdk:"id"`
-	KeyName   types.String `tfsdk:"key_name"`
-	Role      types.String `tfsdk:"role"`
-	Token     types.String `tfsdk:"token"`
-	CreatedAt types.String `tfsdk:"created_at"`
+	GraphId types.String `tfsdk:"graph_id"`
+	Role    types.String `tfsdk:"role"`
+	ApiKeyResourceModel
+}
+
+func (m *GraphApiKeyResourceModel) refresh(key apiKey) {
+	m.ApiKeyResourceModel.refresh(key)
+	m.Role = types.StringValue(key.Role)
 }
 
 func NewGraphApiKeyResource() resource.Resource {
-	return &GraphApiKeyResource{}
+	r := &GraphApiKeyResource{}
+	r.apiKeyResource = apiKeyResource{owner: r, kind: "graph api key"}
+	return r
 }
 
 func (r *GraphApiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,7 +53,7 @@ func (r *GraphApiKeyResource) Metadata(_ context.Context, req resource.MetadataR
 func (r *GraphApiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage an API key for a specific graph",
-		Attributes: map[string]schema.Attribute{
+		Attributes: withApiKeyRotationAttributes(map[string]schema.Attribute{
 			"graph_id": schema.StringAttribute{
 				Description: "ID of the graph",
 				Required:    true,
@@ -75,11 +84,25 @@ func (r *GraphApiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"role": schema.StringAttribute{
-				Description: "Role of the API key. This role can be either `GRAPH_ADMIN`, `CONTRIBUTOR`, `DOCUMENTER`, `OBSERVER` or `CONSUMER`",
+				Description: "Role of the API key. This role can be either `GRAPH_ADMIN`, `CONTRIBUTOR`, `DOCUMENTER`, `OBSERVER` or `CONSUMER`. Defaults to `GRAPH_ADMIN`. Changing the role creates a new API key",
+				Optional:    true,
 				Computed:    true,
+				Default:     stringdefault.StaticString(string(client.UserPermissionGraphAdmin)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.UserPermissionGraphAdmin),
+						string(client.UserPermissionContributor),
+						string(client.UserPermissionDocumenter),
+						string(client.UserPermissionObserver),
+						string(client.UserPermissionConsumer),
+					),
+				},
 			},
 			"token": schema.StringAttribute{
-				Description: "Authentication token of the API key. This value is only fully available when creating the API key, the current value is partially masked",
+				Description: "Authentication token of the API key. This value is only fully available when creating the API key, the current value is partially masked. An imported API key only has the masked value. Use the `apollostudio_graph_api_key_token` ephemeral resource to keep the token out of the state",
 				Computed:    true,
 				Sensitive:   true,
 			},
@@ -87,28 +110,10 @@ func (r *GraphApiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "Creation date of the API key",
 				Computed:    true,
 			},
-		},
+		}),
 	}
 }
 
-func (r *GraphApiKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*client.ApolloClient)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	r.client = client
-}
-
 func (r *GraphApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Return values from plan
 	var plan GraphApiKeyResourceModel
@@ -119,7 +124,7 @@ func (r *GraphApiKeyResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Create the API key
-	apiKey, err := r.client.CreateGraphApiKey(ctx, plan.GraphId.ValueString(), plan.KeyName.ValueString())
+	apiKey, err := r.client.CreateGraphApiKey(ctx, plan.GraphId.ValueString(), plan.KeyName.ValueString(), client.UserPermission(plan.Role.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating graph api key",
@@ -143,112 +148,56 @@ func (r *GraphApiKeyResource) Create(ctx context.Context, req resource.CreateReq
 	plan.Token = types.StringValue(apiKey.Token)
 	plan.CreatedAt = types.StringValue(apiKey.CreatedAt)
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-}
-
-func (r *GraphApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// Get current state
-	var state GraphApiKeyResourceModel
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Get refreshed graph api key from Apollo Studio
-	apiKey, err := r.client.GetGraphApiKey(ctx, state.GraphId.ValueString(), state.Id.ValueString())
+	// Compute when the API key must be rotated
+	plan.ExpiresAt, err = apiKeyExpiresAt(plan.RotationPeriod)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Failed to get graph api key",
-			fmt.Sprintf("Failed to get graph api key: %s", err.Error()),
-		)
-		return
-	}
-
-	// If not found
-	if apiKey.Id == "" {
-		resp.Diagnostics.AddError(
-			"Failed to get graph api key",
-			fmt.Sprintf("Failed to get graph api key: %s because the API key wasn't found. Might have been deleted", state.Id.ValueString()),
+			"Error creating graph api key",
+			"Could not parse rotation period, unexpected error: "+err.Error(),
 		)
-		// resp.State.RemoveResource(ctx)
 		return
 	}
 
-	// Override state with refreshed values
-	state.Id = types.StringValue(apiKey.Id)
-	state.Role = types.StringValue(apiKey.Role)
-	state.KeyName = types.StringValue(apiKey.KeyName)
-	state.CreatedAt = types.StringValue(apiKey.CreatedAt)
-
-	// Set refreshed state
-	diags = resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
-func (r *GraphApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Return values from plan
-	var plan GraphApiKeyResourceModel
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+func (r *GraphApiKeyResource) newApiKeyModel() apiKeyResourceModel {
+	return &GraphApiKeyResourceModel{}
+}
 
-	// Get current state
-	var state GraphApiKeyResourceModel
-	diags = req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+func (r *GraphApiKeyResource) importApiKeyModel(_ context.Context, id string) (apiKeyResourceModel, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	graphId, keyRef, found := strings.Cut(id, ":")
+	if !found || graphId == "" || keyRef == "" {
+		diags.AddError(
+			"Invalid graph api key ID",
+			fmt.Sprintf("Invalid graph api key ID: %s, expected <graph-id>:<key-id> or <graph-id>:<key-name>", id),
+		)
+		return nil, "", diags
 	}
+	return &GraphApiKeyResourceModel{GraphId: types.StringValue(graphId)}, keyRef, diags
+}
 
-	// Update the API key
-	err := r.client.RenameGraphApiKey(ctx, state.GraphId.ValueString(), state.Id.ValueString(), plan.KeyName.ValueString())
+func (r *GraphApiKeyResource) getApiKeys(ctx context.Context, model apiKeyResourceModel) ([]apiKey, error) {
+	graphApiKeys, err := r.client.GetGraphApiKeys(ctx, model.(*GraphApiKeyResourceModel).GraphId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating graph api key",
-			"Could not update graph api key "+plan.Id.ValueString()+", unexpected error: "+err.Error(),
-		)
-		return
+		return nil, err
 	}
-
-	// Map response body to schema and populate response
-	plan.Id = state.Id
-	plan.Role = state.Role
-	plan.Token = state.Token
-	plan.CreatedAt = state.CreatedAt
-
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	apiKeys := make([]apiKey, 0, len(graphApiKeys))
+	for _, ak := range graphApiKeys {
+		apiKeys = append(apiKeys, apiKey(ak))
 	}
+	return apiKeys, nil
 }
 
-func (r *GraphApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Retrieve values from state
-	var state GraphApiKeyResourceModel
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+func (r *GraphApiKeyResource) renameApiKey(ctx context.Context, model apiKeyResourceModel, apiKeyId string, keyName string) error {
+	return r.client.RenameGraphApiKey(ctx, model.(*GraphApiKeyResourceModel).GraphId.ValueString(), apiKeyId, keyName)
+}
 
-	// Delete the API key
-	err := r.client.RemoveGraphApiKey(ctx, state.GraphId.ValueString(), state.Id.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to delete graph api key",
-			"Failed to delete graph api key "+state.Id.ValueString()+", unexpected error: "+err.Error(),
-		)
-		return
-	}
+func (r *GraphApiKeyResource) removeApiKey(ctx context.Context, model apiKeyResourceModel, apiKeyId string) error {
+	return r.client.RemoveGraphApiKey(ctx, model.(*GraphApiKeyResourceModel).GraphId.ValueString(), apiKeyId)
 }
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphApiKeyResource(t *testing.T) {
+	randomId := uuid.New().String()[0:8]
+	keyName := fmt.Sprintf("test-%s", randomId)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_graph_api_key" "this" {
+					graph_id = "testacc-terraform"
+					key_name = "` + keyName + `"
+					role     = "CONSUMER"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_graph_api_key.this", "key_name", keyName),
+					resource.TestCheckResourceAttr("apollostudio_graph_api_key.this", "role", "CONSUMER"),
+					resource.TestCheckResourceAttrSet("apollostudio_graph_api_key.this", "token"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:            "apollostudio_graph_api_key.this",
+				ImportState:             true,
+				ImportStateId:           "testacc-terraform:" + keyName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Changing the role replaces the API key
+			{
+				Config: providerConfig + `resource "apollostudio_graph_api_key" "this" {
+					graph_id = "testacc-terraform"
+					key_name = "` + keyName + `"
+					role     = "OBSERVER"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_graph_api_key.this", "role", "OBSERVER"),
+				),
+			},
+			// Rotation with a grace period
+			{
+				Config: providerConfig + `resource "apollostudio_graph_api_key" "this" {
+					graph_id        = "testacc-terraform"
+					key_name        = "` + keyName + `"
+					role            = "OBSERVER"
+					rotation_period = "720h"
+					grace_period    = "1s"
+
+					rotation_triggers = {
+						version = "1"
+					}
+
+					lifecycle {
+						create_before_destroy = true
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_graph_api_key.this", "rotation_triggers.version", "1"),
+					resource.TestCheckResourceAttrSet("apollostudio_graph_api_key.this", "expires_at"),
+				),
+			},
+			// Removing the rotation period keeps the API key
+			{
+				Config: providerConfig + `resource "apollostudio_graph_api_key" "this" {
+					graph_id     = "testacc-terraform"
+					key_name     = "` + keyName + `"
+					role         = "OBSERVER"
+					grace_period = "1s"
+
+					rotation_triggers = {
+						version = "1"
+					}
+
+					lifecycle {
+						create_before_destroy = true
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("apollostudio_graph_api_key.this", "expires_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &GraphApiKeyTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &GraphApiKeyTokenEphemeralResource{}
+)
+
+type GraphApiKeyTokenEphemeralResource struct {
+	client *client.ApolloClient
+}
+
+type GraphApiKeyTokenEphemeralResourceModel struct {
+	GraphId types.String `tfsdk:"graph_id"`
+	KeyName types.String `tfsdk:"key_name"`
+	Role    types.String `tfsdk:"role"`
+	Id      types.String `tfsdk:"id"`
+	Token   types.String `tfsdk:"token"`
+}
+
+func NewGraphApiKeyTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &GraphApiKeyTokenEphemeralResource{}
+}
+
+func (r *GraphApiKeyTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_api_key_token"
+}
+
+func (r *GraphApiKeyTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provide the token of a graph API key without it ever landing in the state or the plan. Set `key_name` to mint a new API key, or `id` to fetch an existing one, e.g. owned by an `apollostudio_graph_api_key` resource. A minted API key is kept once the run ends so its token stays valid wherever it was written, and a new one is minted every time Terraform opens the ephemeral resource. The API only returns the full token of an API key right after its creation, a fetched API key has its masked token. Requires Terraform 1.10 or later",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"key_name": schema.StringAttribute{
+				Description: "Name of the API key to mint. Conflicts with `id`",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id")),
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role of the API key to mint. This role can be either `GRAPH_ADMIN`, `CONTRIBUTOR`, `DOCUMENTER`, `OBSERVER` or `CONSUMER`. Defaults to `GRAPH_ADMIN`. Conflicts with `id`",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("id")),
+					stringvalidator.OneOf(
+						string(client.UserPermissionGraphAdmin),
+						string(client.UserPermissionContributor),
+						string(client.UserPermissionDocumenter),
+						string(client.UserPermissionObserver),
+						string(client.UserPermissionConsumer),
+					),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the existing API key to fetch. Conflicts with `key_name`",
+				Optional:    true,
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "Authentication token of the API key, masked for a fetched API key",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *GraphApiKeyTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GraphApiKeyTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data GraphApiKeyTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiKey client.GraphApiKey
+	if data.Id.IsNull() {
+		apiKey = r.mint(ctx, data, &resp.Diagnostics)
+	} else {
+		apiKey = r.fetch(ctx, data, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(apiKey.Id)
+	data.KeyName = types.StringValue(apiKey.KeyName)
+	data.Role = types.StringValue(apiKey.Role)
+	data.Token = types.StringValue(apiKey.Token)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// mint creates a new API key, which is kept once the run ends.
+func (r *GraphApiKeyTokenEphemeralResource) mint(ctx context.Context, data GraphApiKeyTokenEphemeralResourceModel, diags *diag.Diagnostics) client.GraphApiKey {
+	role := client.UserPermissionGraphAdmin
+	if !data.Role.IsNull() {
+		role = client.UserPermission(data.Role.ValueString())
+	}
+
+	apiKey, err := r.client.CreateGraphApiKey(ctx, data.GraphId.ValueString(), data.KeyName.ValueString(), role)
+	if err != nil {
+		diags.AddError(
+			"Error creating graph api key",
+			"Could not create graph api key, unexpected error: "+err.Error(),
+		)
+		return client.GraphApiKey{}
+	}
+
+	// GraphQL API does not return an error when the API key creation fails
+	if apiKey.Id == "" {
+		diags.AddError(
+			"Error creating graph api key",
+			"Could not create graph api key, unexpected error. Might be the API key used to configure the provider does not have the right permissions to create API key on graph : "+data.GraphId.ValueString()+".",
+		)
+		return client.GraphApiKey{}
+	}
+
+	return apiKey
+}
+
+// fetch looks up an existing API key, whose lifecycle is managed elsewhere.
+func (r *GraphApiKeyTokenEphemeralResource) fetch(ctx context.Context, data GraphApiKeyTokenEphemeralResourceModel, diags *diag.Diagnostics) client.GraphApiKey {
+	apiKey, err := r.client.GetGraphApiKey(ctx, data.GraphId.ValueString(), data.Id.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Failed to get graph api key",
+			fmt.Sprintf("Failed to get graph api key: %s", err.Error()),
+		)
+		return client.GraphApiKey{}
+	}
+
+	if apiKey.Id == "" {
+		diags.AddError(
+			"Failed to get graph api key",
+			fmt.Sprintf("Failed to get graph api key: %s because the API key wasn't found.", data.Id.ValueString()),
+		)
+		return client.GraphApiKey{}
+	}
+	return apiKey
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccGraphApiKeyTokenEphemeralResource(t *testing.T) {
+	randomId := uuid.New().String()[0:8]
+	keyName := fmt.Sprintf("test-%s", randomId)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			// Minted API keys outlive the run, remove them once the test is over
+			t.Cleanup(func() {
+				ctx := context.Background()
+				apiKeys, err := testClient().GetGraphApiKeys(ctx, "testacc-terraform")
+				if err != nil {
+					t.Errorf("Failed to list graph api keys: %s", err)
+					return
+				}
+				for _, apiKey := range apiKeys {
+					if apiKey.KeyName == keyName {
+						if err := testClient().RemoveGraphApiKey(ctx, "testacc-terraform", apiKey.Id); err != nil {
+							t.Errorf("Failed to delete graph api key %s: %s", apiKey.Id, err)
+						}
+					}
+				}
+			})
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"apollostudio": testAccProtoV6ProviderFactories["apollostudio"],
+			"echo":         echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			// Mint a new API key
+			{
+				Config: providerConfig + `ephemeral "apollostudio_graph_api_key_token" "this" {
+					graph_id = "testacc-terraform"
+					key_name = "` + keyName + `"
+					role     = "OBSERVER"
+				}
+
+				provider "echo" {
+					data = ephemeral.apollostudio_graph_api_key_token.this
+				}
+
+				resource "echo" "this" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.this", "data.id"),
+					resource.TestCheckResourceAttrSet("echo.this", "data.token"),
+					resource.TestCheckResourceAttr("echo.this", "data.key_name", keyName),
+					resource.TestCheckResourceAttr("echo.this", "data.role", "OBSERVER"),
+				),
+			},
+			// Fetch an API key owned by a resource
+			{
+				Config: providerConfig + `resource "apollostudio_graph_api_key" "this" {
+					graph_id = "testacc-terraform"
+					key_name = "` + keyName + `-owned"
+					role     = "CONSUMER"
+				}
+
+				ephemeral "apollostudio_graph_api_key_token" "this" {
+					graph_id = apollostudio_graph_api_key.this.graph_id
+					id       = apollostudio_graph_api_key.this.id
+				}
+
+				provider "echo" {
+					data = ephemeral.apollostudio_graph_api_key_token.this
+				}
+
+				resource "echo" "this" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("echo.this", "data.id", "apollostudio_graph_api_key.this", "id"),
+					resource.TestCheckResourceAttr("echo.this", "data.key_name", keyName+"-owned"),
+					resource.TestCheckResourceAttr("echo.this", "data.role", "CONSUMER"),
+				),
+			},
+		},
+	})
+}
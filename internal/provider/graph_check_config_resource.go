@@ -0,0 +1,466 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource                = &GraphCheckConfigResource{}
+	_ resource.ResourceWithConfigure   = &GraphCheckConfigResource{}
+	_ resource.ResourceWithImportState = &GraphCheckConfigResource{}
+)
+
+// defaultCheckTimeRangeSeconds is the time window of the operations checked by default, 7 days.
+const defaultCheckTimeRangeSeconds = 7 * 24 * 60 * 60
+
+// excludedOperationsManagedKey is the private state key recording whether excluded_operations is configured,
+// Delete leaves the excluded operations untouched otherwise.
+const excludedOperationsManagedKey = "excluded_operations_managed"
+
+type GraphCheckConfigResource struct {
+	client *client.ApolloClient
+}
+
+type ExcludedClientModel struct {
+	Name    types.String `tfsdk:"name"`
+	Version types.String `tfsdk:"version"`
+}
+
+type GraphCheckConfigResourceModel struct {
+	GraphId                           types.String          `tfsdk:"graph_id"`
+	TimeRangeSeconds                  types.Int64           `tfsdk:"time_range_seconds"`
+	OperationCountThreshold           types.Int64           `tfsdk:"operation_count_threshold"`
+	OperationCountThresholdPercentage types.Float64         `tfsdk:"operation_count_threshold_percentage"`
+	IncludeBaseVariant                types.Bool            `tfsdk:"include_base_variant"`
+	IncludedVariants                  types.Set             `tfsdk:"included_variants"`
+	ExcludedClients                   []ExcludedClientModel `tfsdk:"excluded_clients"`
+	ExcludedOperations                types.Set             `tfsdk:"excluded_operations"`
+	ExcludedOperationNames            types.Set             `tfsdk:"excluded_operation_names"`
+	DownstreamVariantsToCheck         types.Set             `tfsdk:"downstream_variants_to_check"`
+	ProposalChangeMismatchSeverity    types.String          `tfsdk:"proposal_change_mismatch_severity"`
+}
+
+func NewGraphCheckConfigResource() resource.Resource {
+	return &GraphCheckConfigResource{}
+}
+
+func (r *GraphCheckConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_check_config"
+}
+
+func (r *GraphCheckConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the default configuration of the checks run against a graph. Destroying this resource restores the default configuration. " +
+			"`excluded_operations` and the `IGNORE` overrides of `apollostudio_operation_check_override` manage the same list of ignored operations, only use one of them for a graph",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"time_range_seconds": schema.Int64Attribute{
+				Description: "Time window, in seconds, of the operations checked against schema changes. Defaults to `604800` (7 days)",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultCheckTimeRangeSeconds),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"operation_count_threshold": schema.Int64Attribute{
+				Description: "Minimum number of requests within the time window for an operation to be checked. Defaults to `1`",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"operation_count_threshold_percentage": schema.Float64Attribute{
+				Description: "Minimum percentage of the requests within the time window for an operation to be checked. Defaults to `0`",
+				Optional:    true,
+				Computed:    true,
+				Default:     float64default.StaticFloat64(0),
+				Validators: []validator.Float64{
+					float64validator.Between(0, 100),
+				},
+			},
+			"include_base_variant": schema.BoolAttribute{
+				Description: "Whether the operations of the checked variant are used. Defaults to `true`",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"included_variants": schema.SetAttribute{
+				Description: "Names of the other variants whose operations are used",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"excluded_clients": schema.ListNestedAttribute{
+				Description: "Clients whose operations are ignored",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the client",
+							Required:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "Version of the client. Every version is ignored when omitted",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"excluded_operations": schema.SetAttribute{
+				Description: "IDs of the operations that are ignored. The ignored operations are left untouched when omitted, " +
+					"removing it from the configuration keeps the operations ignored. Conflicts with `apollostudio_operation_check_override` using `IGNORE`",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"excluded_operation_names": schema.SetAttribute{
+				Description: "Names of the operations that are ignored",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"downstream_variants_to_check": schema.SetAttribute{
+				Description: "Names of the contract variants whose checks fail the check of their source variant",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"proposal_change_mismatch_severity": schema.StringAttribute{
+				Description: "Outcome of a check whose changes don't match an approved proposal. This can be one of: `OFF`, `WARN`, `ERROR`. Defaults to `OFF`",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(client.ProposalChangeMismatchSeverityOff)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.ProposalChangeMismatchSeverityOff),
+						string(client.ProposalChangeMismatchSeverityWarn),
+						string(client.ProposalChangeMismatchSeverityError),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *GraphCheckConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// newCheckConfiguration builds the check configuration sent to the API from the model.
+func newCheckConfiguration(ctx context.Context, model GraphCheckConfigResourceModel) (client.CheckConfiguration, error) {
+	config := client.CheckConfiguration{
+		TimeRangeSeconds:                  model.TimeRangeSeconds.ValueInt64(),
+		OperationCountThreshold:           int(model.OperationCountThreshold.ValueInt64()),
+		OperationCountThresholdPercentage: model.OperationCountThresholdPercentage.ValueFloat64(),
+		IncludeBaseVariant:                model.IncludeBaseVariant.ValueBool(),
+		ExcludedClients:                   make([]client.ClientFilter, 0, len(model.ExcludedClients)),
+		ExcludedOperations:                make([]client.ExcludedOperation, 0),
+		ExcludedOperationNames:            make([]client.OperationNameFilter, 0),
+		ProposalChangeMismatchSeverity:    model.ProposalChangeMismatchSeverity.ValueString(),
+	}
+	if diags := model.IncludedVariants.ElementsAs(ctx, &config.IncludedVariants, false); diags.HasError() {
+		return client.CheckConfiguration{}, fmt.Errorf("could not read included variants")
+	}
+	if diags := model.DownstreamVariantsToCheck.ElementsAs(ctx, &config.DownstreamVariantsToCheck, false); diags.HasError() {
+		return client.CheckConfiguration{}, fmt.Errorf("could not read downstream variants to check")
+	}
+	for _, excludedClient := range model.ExcludedClients {
+		config.ExcludedClients = append(config.ExcludedClients, client.ClientFilter{
+			Name:    excludedClient.Name.ValueString(),
+			Version: excludedClient.Version.ValueString(),
+		})
+	}
+	var excludedOperations, excludedOperationNames []string
+	if !model.ExcludedOperations.IsUnknown() {
+		if diags := model.ExcludedOperations.ElementsAs(ctx, &excludedOperations, false); diags.HasError() {
+			return client.CheckConfiguration{}, fmt.Errorf("could not read excluded operations")
+		}
+	}
+	for _, id := range excludedOperations {
+		config.ExcludedOperations = append(config.ExcludedOperations, client.ExcludedOperation{Id: id})
+	}
+	if diags := model.ExcludedOperationNames.ElementsAs(ctx, &excludedOperationNames, false); diags.HasError() {
+		return client.CheckConfiguration{}, fmt.Errorf("could not read excluded operation names")
+	}
+	for _, name := range excludedOperationNames {
+		config.ExcludedOperationNames = append(config.ExcludedOperationNames, client.OperationNameFilter{Name: name})
+	}
+	return config, nil
+}
+
+// newGraphCheckConfigModel maps the check configuration returned by the API to the model.
+func newGraphCheckConfigModel(graphId string, config client.CheckConfiguration) GraphCheckConfigResourceModel {
+	model := GraphCheckConfigResourceModel{
+		GraphId:                           types.StringValue(graphId),
+		TimeRangeSeconds:                  types.Int64Value(config.TimeRangeSeconds),
+		OperationCountThreshold:           types.Int64Value(int64(config.OperationCountThreshold)),
+		OperationCountThresholdPercentage: types.Float64Value(config.OperationCountThresholdPercentage),
+		IncludeBaseVariant:                types.BoolValue(config.IncludeBaseVariant),
+		IncludedVariants:                  stringSetModel(config.IncludedVariants),
+		DownstreamVariantsToCheck:         stringSetModel(config.DownstreamVariantsToCheck),
+		ProposalChangeMismatchSeverity:    types.StringValue(config.ProposalChangeMismatchSeverity),
+	}
+	for _, excludedClient := range config.ExcludedClients {
+		version := types.StringNull()
+		if excludedClient.Version != "" {
+			version = types.StringValue(excludedClient.Version)
+		}
+		model.ExcludedClients = append(model.ExcludedClients, ExcludedClientModel{
+			Name:    types.StringValue(excludedClient.Name),
+			Version: version,
+		})
+	}
+	model.ExcludedOperations = excludedOperationsModel(config.ExcludedOperations)
+	excludedOperationNames := make([]string, 0, len(config.ExcludedOperationNames))
+	for _, operationName := range config.ExcludedOperationNames {
+		excludedOperationNames = append(excludedOperationNames, operationName.Name)
+	}
+	model.ExcludedOperationNames = stringSetModel(excludedOperationNames)
+	return model
+}
+
+// excludedOperationsModel maps the excluded operations returned by the API to the model.
+func excludedOperationsModel(operations []client.ExcludedOperation) types.Set {
+	ids := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		ids = append(ids, operation.Id)
+	}
+	return stringSetModel(ids)
+}
+
+// applyCheckConfiguration updates the check configuration from the plan. The excluded operations are kept as is
+// when they aren't configured, so the operations ignored by apollostudio_operation_check_override survive.
+func (r *GraphCheckConfigResource) applyCheckConfiguration(ctx context.Context, plan *GraphCheckConfigResourceModel) error {
+	config, err := newCheckConfiguration(ctx, *plan)
+	if err != nil {
+		return err
+	}
+
+	managed := !plan.ExcludedOperations.IsUnknown()
+	if !managed {
+		current, err := r.client.GetCheckConfiguration(ctx, plan.GraphId.ValueString())
+		if err != nil {
+			return err
+		}
+		config.ExcludedOperations = current.ExcludedOperations
+	}
+
+	updated, err := r.client.UpdateCheckConfiguration(ctx, plan.GraphId.ValueString(), config)
+	if err != nil {
+		return err
+	}
+	if !managed {
+		plan.ExcludedOperations = excludedOperationsModel(updated.ExcludedOperations)
+	}
+	return nil
+}
+
+func (r *GraphCheckConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan GraphCheckConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := []byte("false")
+	if !plan.ExcludedOperations.IsUnknown() {
+		managed = []byte("true")
+	}
+
+	// Update the check configuration
+	err := r.applyCheckConfiguration(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update graph check configuration",
+			fmt.Sprintf("Failed to update graph check configuration: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, excludedOperationsManagedKey, managed)...)
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *GraphCheckConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Return values from state
+	var state GraphCheckConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the check configuration
+	config, err := r.client.GetCheckConfiguration(ctx, state.GraphId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get graph check configuration",
+			fmt.Sprintf("Failed to get graph check configuration: %s", err.Error()),
+		)
+		return
+	}
+
+	if config.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get graph check configuration",
+			fmt.Sprintf("Failed to get graph check configuration: %s because the graph wasn't found. Might have been deleted", state.GraphId.ValueString()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate response
+	state = newGraphCheckConfigModel(state.GraphId.ValueString(), config)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *GraphCheckConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Return values from plan
+	var plan GraphCheckConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := []byte("false")
+	if !plan.ExcludedOperations.IsUnknown() {
+		managed = []byte("true")
+	}
+
+	// Update the check configuration
+	err := r.applyCheckConfiguration(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update graph check configuration",
+			fmt.Sprintf("Failed to update graph check configuration: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, excludedOperationsManagedKey, managed)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *GraphCheckConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Return values from state
+	var state GraphCheckConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultConfig := client.CheckConfiguration{
+		TimeRangeSeconds:               defaultCheckTimeRangeSeconds,
+		OperationCountThreshold:        1,
+		IncludeBaseVariant:             true,
+		ProposalChangeMismatchSeverity: string(client.ProposalChangeMismatchSeverityOff),
+	}
+
+	// Keep the excluded operations that aren't configured, including after an import
+	managed, diags := req.Private.GetKey(ctx, excludedOperationsManagedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if string(managed) != "true" {
+		current, err := r.client.GetCheckConfiguration(ctx, state.GraphId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to reset graph check configuration",
+				fmt.Sprintf("Failed to reset graph check configuration: %s", err.Error()),
+			)
+			return
+		}
+		defaultConfig.ExcludedOperations = current.ExcludedOperations
+	}
+
+	// Restore the default configuration
+	_, err := r.client.UpdateCheckConfiguration(ctx, state.GraphId.ValueString(), defaultConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to reset graph check configuration",
+			fmt.Sprintf("Failed to reset graph check configuration: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (r *GraphCheckConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("graph_id"), req, resp)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphCheckConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_graph_check_config" "this" {
+					graph_id = "testacc-terraform"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_graph_check_config.this", "time_range_seconds", "604800"),
+					resource.TestCheckResourceAttr("apollostudio_graph_check_config.this", "operation_count_threshold", "1"),
+					resource.TestCheckResourceAttr("apollostudio_graph_check_config.this", "include_base_variant", "true"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:                         "apollostudio_graph_check_config.this",
+				ImportState:                          true,
+				ImportStateId:                        "testacc-terraform",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "graph_id",
+			},
+			// Update and read testing
+			{
+				Config: providerConfig + `resource "apollostudio_graph_check_config" "this" {
+					graph_id                  = "testacc-terraform"
+					time_range_seconds        = 1209600
+					operation_count_threshold = 10
+					excluded_clients = [
+						{
+							name = "terraform"
+						},
+					]
+					excluded_operation_names = ["IntrospectionQuery"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_graph_check_config.this", "time_range_seconds", "1209600"),
+					resource.TestCheckResourceAttr("apollostudio_graph_check_config.this", "operation_count_threshold", "10"),
+					resource.TestCheckResourceAttr("apollostudio_graph_check_config.this", "excluded_clients.0.name", "terraform"),
+					resource.TestCheckResourceAttr("apollostudio_graph_check_config.this", "excluded_operation_names.#", "1"),
+				),
+			},
+		},
+	})
+}
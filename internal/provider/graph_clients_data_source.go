@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var _ datasource.DataSource = &GraphClientsDataSource{}
+
+type GraphClientsDataSource struct {
+	client *client.ApolloClient
+}
+
+type GraphClientModel struct {
+	Name           types.String `tfsdk:"name"`
+	Version        types.String `tfsdk:"version"`
+	LastSeenAt     types.String `tfsdk:"last_seen_at"`
+	RequestCount   types.Int64  `tfsdk:"request_count"`
+	OperationCount types.Int64  `tfsdk:"operation_count"`
+}
+
+type GraphClientsDataSourceModel struct {
+	GraphId     types.String       `tfsdk:"graph_id"`
+	VariantName types.String       `tfsdk:"variant_name"`
+	TimeWindow  types.String       `tfsdk:"time_window"`
+	Clients     []GraphClientModel `tfsdk:"clients"`
+}
+
+// graphClientTotals is the traffic of a client version summed over its operations.
+type graphClientTotals struct {
+	name         string
+	version      string
+	lastSeenAt   string
+	requestCount int64
+	operations   map[string]bool
+}
+
+func NewGraphClientsDataSource() datasource.DataSource {
+	return &GraphClientsDataSource{}
+}
+
+func (d *GraphClientsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_clients"
+}
+
+func (d *GraphClientsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provide the clients which sent operations to a specific graph variant. Clients that didn't send any operation during the period aren't listed",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+			},
+			"variant_name": schema.StringAttribute{
+				Description: "Name of the variant",
+				Required:    true,
+			},
+			"time_window": timeWindowAttribute,
+			"clients": schema.ListNestedAttribute{
+				Description: "List of clients, sorted by name and version",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the client, empty when the client didn't identify itself",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "Version of the client, empty when the client didn't identify itself",
+							Computed:    true,
+						},
+						"last_seen_at": schema.StringAttribute{
+							Description: "Start of the latest day during which the client sent an operation",
+							Computed:    true,
+						},
+						"request_count": schema.Int64Attribute{
+							Description: "Number of requests sent by the client",
+							Computed:    true,
+						},
+						"operation_count": schema.Int64Attribute{
+							Description: "Number of distinct operations sent by the client",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GraphClientsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GraphClientsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GraphClientsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from, to, err := statsWindow(data.TimeWindow)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get graph clients",
+			fmt.Sprintf("Failed to get graph clients: could not parse time window: %s", err.Error()),
+		)
+		return
+	}
+
+	stats, err := d.client.GetQueryStats(ctx, data.GraphId.ValueString(), data.VariantName.ValueString(), from, to)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get graph clients",
+			fmt.Sprintf("Failed to get graph clients: %s", err.Error()),
+		)
+		return
+	}
+
+	// Sum the traffic of each client version
+	totals := map[string]*graphClientTotals{}
+	for _, stat := range stats {
+		key := stat.GroupBy.ClientName + "@" + stat.GroupBy.ClientVersion
+		total, ok := totals[key]
+		if !ok {
+			total = &graphClientTotals{
+				name:       stat.GroupBy.ClientName,
+				version:    stat.GroupBy.ClientVersion,
+				operations: map[string]bool{},
+			}
+			totals[key] = total
+		}
+		total.requestCount += stat.Metrics.TotalRequestCount
+		total.operations[stat.GroupBy.QueryId] = true
+		// Timestamps share the same RFC 3339 format, they can be compared as strings
+		if stat.Timestamp > total.lastSeenAt {
+			total.lastSeenAt = stat.Timestamp
+		}
+	}
+
+	data.Clients = make([]GraphClientModel, 0, len(totals))
+	for _, total := range totals {
+		data.Clients = append(data.Clients, GraphClientModel{
+			Name:           types.StringValue(total.name),
+			Version:        types.StringValue(total.version),
+			LastSeenAt:     types.StringValue(total.lastSeenAt),
+			RequestCount:   types.Int64Value(total.requestCount),
+			OperationCount: types.Int64Value(int64(len(total.operations))),
+		})
+	}
+	sort.Slice(data.Clients, func(i, j int) bool {
+		if data.Clients[i].Name.ValueString() != data.Clients[j].Name.ValueString() {
+			return data.Clients[i].Name.ValueString() < data.Clients[j].Name.ValueString()
+		}
+		return data.Clients[i].Version.ValueString() < data.Clients[j].Version.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
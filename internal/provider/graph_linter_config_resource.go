@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource                = &GraphLinterConfigResource{}
+	_ resource.ResourceWithConfigure   = &GraphLinterConfigResource{}
+	_ resource.ResourceWithImportState = &GraphLinterConfigResource{}
+)
+
+type GraphLinterConfigResource struct {
+	client *client.ApolloClient
+}
+
+type GraphLinterConfigResourceModel struct {
+	GraphId                  types.String `tfsdk:"graph_id"`
+	Rules                    types.Map    `tfsdk:"rules"`
+	IgnoredDirectives        types.Set    `tfsdk:"ignored_directives"`
+	IgnoreExistingViolations types.Bool   `tfsdk:"ignore_existing_violations"`
+}
+
+func NewGraphLinterConfigResource() resource.Resource {
+	return &GraphLinterConfigResource{}
+}
+
+func (r *GraphLinterConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_linter_config"
+}
+
+func (r *GraphLinterConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the schema linter configuration of a graph. Destroying this resource clears the ignored directives and `ignore_existing_violations`, rule levels are left untouched",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"rules": schema.MapAttribute{
+				Description: "Level of the linter rules, keyed by rule name (e.g. `FIELD_NAMES_SHOULD_BE_CAMEL_CASE`). The level can be one of: `ERROR`, `WARNING`, `IGNORED`. Rules not listed keep their current level",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(
+						stringvalidator.OneOf(
+							string(client.DiagnosticLevelError),
+							string(client.DiagnosticLevelWarning),
+							string(client.DiagnosticLevelIgnored),
+						),
+					),
+				},
+			},
+			"ignored_directives": schema.SetAttribute{
+				Description: "Names of the directives whose usages are not linted, without the `@`",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"ignore_existing_violations": schema.BoolAttribute{
+				Description: "Whether violations already present in the published schema are ignored, only reporting the ones introduced by a change. Defaults to `false`",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *GraphLinterConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// linterConfigChanges builds the changes applied to the linter configuration from the model.
+func linterConfigChanges(ctx context.Context, model GraphLinterConfigResourceModel) (client.GraphLinterConfigurationChangesInput, error) {
+	var rules map[string]string
+	if diags := model.Rules.ElementsAs(ctx, &rules, false); diags.HasError() {
+		return client.GraphLinterConfigurationChangesInput{}, fmt.Errorf("could not read rules")
+	}
+	ignoredDirectives := make([]string, 0)
+	if diags := model.IgnoredDirectives.ElementsAs(ctx, &ignoredDirectives, false); diags.HasError() {
+		return client.GraphLinterConfigurationChangesInput{}, fmt.Errorf("could not read ignored directives")
+	}
+
+	ignoreExistingViolations := model.IgnoreExistingViolations.ValueBool()
+	changes := client.GraphLinterConfigurationChangesInput{
+		IgnoreExistingViolations: &ignoreExistingViolations,
+		IgnoredDirectives:        ignoredDirectives,
+		Rules:                    make([]client.LinterRuleLevelConfigurationChangesInput, 0, len(rules)),
+	}
+	for rule, level := range rules {
+		changes.Rules = append(changes.Rules, client.LinterRuleLevelConfigurationChangesInput{
+			Rule:  client.LintRule(rule),
+			Level: client.LintDiagnosticLevel(level),
+		})
+	}
+	sort.Slice(changes.Rules, func(i, j int) bool { return changes.Rules[i].Rule < changes.Rules[j].Rule })
+	return changes, nil
+}
+
+// linterRulesModel maps the rule levels to the model. When managed isn't null, only the rules it contains are kept
+// so the rules left to their default level don't show up as drift.
+func linterRulesModel(config client.GraphLinterConfiguration, managed types.Map) types.Map {
+	if managed.IsNull() && len(config.Rules) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	rules := make(map[string]attr.Value)
+	for _, rule := range config.Rules {
+		if _, ok := managed.Elements()[string(rule.Rule)]; managed.IsNull() || ok {
+			rules[string(rule.Rule)] = types.StringValue(string(rule.Level))
+		}
+	}
+	return types.MapValueMust(types.StringType, rules)
+}
+
+func (r *GraphLinterConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan GraphLinterConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes, err := linterConfigChanges(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update graph linter configuration",
+			fmt.Sprintf("Failed to update graph linter configuration: %s", err.Error()),
+		)
+		return
+	}
+
+	// Update the linter configuration
+	_, err = r.client.UpdateGraphLinterConfiguration(ctx, plan.GraphId.ValueString(), changes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update graph linter configuration",
+			fmt.Sprintf("Failed to update graph linter configuration: %s", err.Error()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *GraphLinterConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Return values from state
+	var state GraphLinterConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the linter configuration
+	config, err := r.client.GetGraphLinterConfiguration(ctx, state.GraphId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get graph linter configuration",
+			fmt.Sprintf("Failed to get graph linter configuration: %s", err.Error()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate response
+	if !state.Rules.IsNull() {
+		state.Rules = linterRulesModel(config, state.Rules)
+	}
+	state.IgnoredDirectives = stringSetModel(config.IgnoredDirectives)
+	state.IgnoreExistingViolations = types.BoolValue(config.IgnoreExistingViolations)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *GraphLinterConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Return values from plan
+	var plan GraphLinterConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes, err := linterConfigChanges(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update graph linter configuration",
+			fmt.Sprintf("Failed to update graph linter configuration: %s", err.Error()),
+		)
+		return
+	}
+
+	// Update the linter configuration
+	_, err = r.client.UpdateGraphLinterConfiguration(ctx, plan.GraphId.ValueString(), changes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update graph linter configuration",
+			fmt.Sprintf("Failed to update graph linter configuration: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *GraphLinterConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Return values from state
+	var state GraphLinterConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Default levels of the rules aren't exposed, so only the other settings are reset
+	ignoreExistingViolations := false
+	_, err := r.client.UpdateGraphLinterConfiguration(ctx, state.GraphId.ValueString(), client.GraphLinterConfigurationChangesInput{
+		IgnoreExistingViolations: &ignoreExistingViolations,
+		IgnoredDirectives:        make([]string, 0),
+		Rules:                    make([]client.LinterRuleLevelConfigurationChangesInput, 0),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to reset graph linter configuration",
+			fmt.Sprintf("Failed to reset graph linter configuration: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (r *GraphLinterConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Import graph linter configuration: %s", req.ID))
+
+	// Get the linter configuration
+	config, err := r.client.GetGraphLinterConfiguration(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get graph linter configuration",
+			fmt.Sprintf("Failed to get graph linter configuration: %s", err.Error()),
+		)
+		return
+	}
+
+	// Every rule is imported, the ones left to their default level can then be removed from the configuration
+	var state GraphLinterConfigResourceModel
+	state.GraphId = types.StringValue(req.ID)
+	state.Rules = linterRulesModel(config, types.MapNull(types.StringType))
+	state.IgnoredDirectives = stringSetModel(config.IgnoredDirectives)
+	state.IgnoreExistingViolations = types.BoolValue(config.IgnoreExistingViolations)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphLinterConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_graph_linter_config" "this" {
+					graph_id = "testacc-terraform"
+					rules = {
+						FIELD_NAMES_SHOULD_BE_CAMEL_CASE = "ERROR"
+					}
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_graph_linter_config.this", "rules.FIELD_NAMES_SHOULD_BE_CAMEL_CASE", "ERROR"),
+					resource.TestCheckResourceAttr("apollostudio_graph_linter_config.this", "ignore_existing_violations", "false"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: providerConfig + `resource "apollostudio_graph_linter_config" "this" {
+					graph_id = "testacc-terraform"
+					rules = {
+						FIELD_NAMES_SHOULD_BE_CAMEL_CASE = "WARNING"
+					}
+					ignored_directives         = ["internal"]
+					ignore_existing_violations = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_graph_linter_config.this", "rules.FIELD_NAMES_SHOULD_BE_CAMEL_CASE", "WARNING"),
+					resource.TestCheckResourceAttr("apollostudio_graph_linter_config.this", "ignored_directives.#", "1"),
+					resource.TestCheckResourceAttr("apollostudio_graph_linter_config.this", "ignore_existing_violations", "true"),
+				),
+			},
+		},
+	})
+}
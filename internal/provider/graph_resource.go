@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -27,9 +28,12 @@ type GraphResource struct {
 }
 
 type GraphResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	Id                         types.String `tfsdk:"id"`
+	Name                       types.String `tfsdk:"name"`
+	Description                types.String `tfsdk:"description"`
+	GraphType                  types.String `tfsdk:"graph_type"`
+	ReportingEnabled           types.Bool   `tfsdk:"reporting_enabled"`
+	HiddenFromUninvitedMembers types.Bool   `tfsdk:"hidden_from_uninvited_members"`
 }
 
 func NewGraphResource() resource.Resource {
@@ -70,6 +74,38 @@ func (r *GraphResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description: "Description of the graph",
 				Required:    true,
 			},
+			"graph_type": schema.StringAttribute{
+				Description: "Type of the graph. This can be one of: `CLASSIC` (monolith), `CLOUD_SUPERGRAPH`, `SELF_HOSTED_SUPERGRAPH`. Changing the type recreates the graph",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.GraphTypeClassic),
+						string(client.GraphTypeCloudSupergraph),
+						string(client.GraphTypeSelfHostedSupergraph),
+					),
+				},
+			},
+			"reporting_enabled": schema.BoolAttribute{
+				Description: "Whether the graph accepts usage reporting from clients and routers",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hidden_from_uninvited_members": schema.BoolAttribute{
+				Description: "Whether the graph is hidden from the non-admin members of the organization that weren't invited to it",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -102,7 +138,16 @@ func (r *GraphResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Create the graph
-	graph, err := r.client.CreateGraph(ctx, plan.Id.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
+	var graphType *client.GraphType
+	if !plan.GraphType.IsUnknown() && !plan.GraphType.IsNull() {
+		value := client.GraphType(plan.GraphType.ValueString())
+		graphType = &value
+	}
+	var hidden *bool
+	if !plan.HiddenFromUninvitedMembers.IsUnknown() && !plan.HiddenFromUninvitedMembers.IsNull() {
+		hidden = plan.HiddenFromUninvitedMembers.ValueBoolPointer()
+	}
+	graph, err := r.client.CreateGraph(ctx, plan.Id.ValueString(), plan.Name.ValueString(), plan.Description.ValueString(), graphType, hidden)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to create graph",
@@ -111,10 +156,26 @@ func (r *GraphResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// Reporting can't be set on creation
+	if !plan.ReportingEnabled.IsUnknown() && !plan.ReportingEnabled.IsNull() && plan.ReportingEnabled.ValueBool() != graph.ReportingEnabled {
+		err := r.client.UpdateGraphReportingEnabled(ctx, graph.Id, plan.ReportingEnabled.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to update graph reporting",
+				fmt.Sprintf("Failed to update graph reporting: %s", err.Error()),
+			)
+			return
+		}
+		graph.ReportingEnabled = plan.ReportingEnabled.ValueBool()
+	}
+
 	// Map response body to schema and populate response
 	plan.Id = types.StringValue(graph.Id)
 	plan.Name = types.StringValue(graph.Name)
 	plan.Description = types.StringValue(graph.Description)
+	plan.GraphType = types.StringValue(graph.GraphType)
+	plan.ReportingEnabled = types.BoolValue(graph.ReportingEnabled)
+	plan.HiddenFromUninvitedMembers = types.BoolValue(graph.HiddenFromUninvitedNonAdminAccountMembers)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -146,6 +207,9 @@ func (r *GraphResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.Id = types.StringValue(graph.Id)
 	state.Name = types.StringValue(graph.Name)
 	state.Description = types.StringValue(graph.Description)
+	state.GraphType = types.StringValue(graph.GraphType)
+	state.ReportingEnabled = types.BoolValue(graph.ReportingEnabled)
+	state.HiddenFromUninvitedMembers = types.BoolValue(graph.HiddenFromUninvitedNonAdminAccountMembers)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -195,6 +259,30 @@ func (r *GraphResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	// Update reporting
+	if plan.ReportingEnabled.ValueBool() != state.ReportingEnabled.ValueBool() {
+		err := r.client.UpdateGraphReportingEnabled(ctx, state.Id.ValueString(), plan.ReportingEnabled.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to update graph reporting",
+				fmt.Sprintf("Failed to update graph reporting: %s", err.Error()),
+			)
+			return
+		}
+	}
+
+	// Update visibility
+	if plan.HiddenFromUninvitedMembers.ValueBool() != state.HiddenFromUninvitedMembers.ValueBool() {
+		err := r.client.UpdateGraphHiddenFromUninvitedMembers(ctx, state.Id.ValueString(), plan.HiddenFromUninvitedMembers.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to update graph visibility",
+				fmt.Sprintf("Failed to update graph visibility: %s", err.Error()),
+			)
+			return
+		}
+	}
+
 	// Saved updated values to state
 	plan.Id = state.Id
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
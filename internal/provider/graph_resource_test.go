@@ -45,6 +45,21 @@ func TestAccGraphResource(t *testing.T) {
 					resource.TestCheckResourceAttr("apollostudio_graph.this", "description", "Test Graph Updated"),
 				),
 			},
+			// Update settings
+			{
+				Config: providerConfig + `resource "apollostudio_graph" "this" {
+					id = "` + graphId + `"
+					name = "` + graphId + `"
+					description = "Test Graph Updated"
+					reporting_enabled = false
+					hidden_from_uninvited_members = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_graph.this", "reporting_enabled", "false"),
+					resource.TestCheckResourceAttr("apollostudio_graph.this", "hidden_from_uninvited_members", "true"),
+					resource.TestCheckResourceAttrSet("apollostudio_graph.this", "graph_type"),
+				),
+			},
 			// Delete is automatically done by the framework
 		},
 	})
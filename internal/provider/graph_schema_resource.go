@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource                = &GraphSchemaResource{}
+	_ resource.ResourceWithConfigure   = &GraphSchemaResource{}
+	_ resource.ResourceWithImportState = &GraphSchemaResource{}
+)
+
+type GraphSchemaResource struct {
+	client *client.ApolloClient
+}
+
+type GraphSchemaResourceModel struct {
+	GraphId     types.String `tfsdk:"graph_id"`
+	VariantName types.String `tfsdk:"variant_name"`
+	Schema      types.String `tfsdk:"schema"`
+	Hash        types.String `tfsdk:"hash"`
+}
+
+func NewGraphSchemaResource() resource.Resource {
+	return &GraphSchemaResource{}
+}
+
+func (r *GraphSchemaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_schema"
+}
+
+func (r *GraphSchemaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the schema of a non-federated (monolith) graph variant. Destroying this resource leaves the published schema untouched",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"variant_name": schema.StringAttribute{
+				Description: "Name of the variant",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schema": schema.StringAttribute{
+				Description: "Full schema of the variant",
+				Required:    true,
+			},
+			"hash": schema.StringAttribute{
+				Description: "Hash of the published schema",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *GraphSchemaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GraphSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan GraphSchemaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Publish the schema
+	hash, err := r.client.UploadGraphSchema(ctx, plan.GraphId.ValueString(), plan.VariantName.ValueString(), plan.Schema.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to publish graph schema",
+			fmt.Sprintf("Failed to publish graph schema: %s", err.Error()),
+		)
+		return
+	}
+
+	plan.Hash = types.StringValue(hash)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *GraphSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Return values from state
+	var state GraphSchemaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the published schema
+	graphSchema, err := r.client.GetGraphSchema(ctx, state.GraphId.ValueString(), state.VariantName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get graph schema",
+			fmt.Sprintf("Failed to get graph schema: %s", err.Error()),
+		)
+		return
+	}
+
+	// Only override the schema when another one was published, so formatting differences don't show up as drift
+	if graphSchema.Hash != state.Hash.ValueString() {
+		tflog.Info(ctx, fmt.Sprintf("Schema of %s@%s changed from %s to %s", state.GraphId.ValueString(), state.VariantName.ValueString(), state.Hash.ValueString(), graphSchema.Hash))
+		state.Schema = types.StringValue(graphSchema.Document)
+		state.Hash = types.StringValue(graphSchema.Hash)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *GraphSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Return values from plan
+	var plan GraphSchemaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Return values from state
+	var state GraphSchemaResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate Schema
+	workflowId, err := r.client.SubmitGraphSchemaCheck(ctx, state.GraphId.ValueString(), state.VariantName.ValueString(), plan.Schema.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to submit a graph validation check",
+			fmt.Sprintf("Failed to submit a graph validation check: %s", err.Error()),
+		)
+		return
+	}
+
+	validationResults, err := r.client.CheckWorkflow(ctx, state.GraphId.ValueString(), workflowId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to check the workflow of a graph validation check",
+			fmt.Sprintf("Failed to check the workflow of a graph validation check: %s", err.Error()),
+		)
+		return
+	}
+
+	addCheckWorkflowDiagnostics(&resp.Diagnostics, "graph schema", validationResults)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Publish the schema
+	hash, err := r.client.UploadGraphSchema(ctx, state.GraphId.ValueString(), state.VariantName.ValueString(), plan.Schema.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to publish graph schema",
+			fmt.Sprintf("Failed to publish graph schema: %s", err.Error()),
+		)
+		return
+	}
+
+	plan.Hash = types.StringValue(hash)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *GraphSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Return values from state
+	var state GraphSchemaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A published schema can't be unpublished, the variant keeps its last schema
+	tflog.Info(ctx, fmt.Sprintf("Removing schema of variant %s@%s from state only", state.GraphId.ValueString(), state.VariantName.ValueString()))
+}
+
+func (r *GraphSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Import graph schema: %s", req.ID))
+	pattern := "^([a-zA-Z0-9_-]+)@([a-zA-Z0-9_-]+)$"
+	re := regexp.MustCompile(pattern)
+	matchs := re.FindStringSubmatch(req.ID)
+	if matchs == nil {
+		resp.Diagnostics.AddError(
+			"Invalid variant ID",
+			fmt.Sprintf("Invalid variant ID: %s, expected <graph-id>@<variant-name>", req.ID),
+		)
+		return
+	}
+
+	// Get the published schema
+	graphSchema, err := r.client.GetGraphSchema(ctx, matchs[1], matchs[2])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get graph schema",
+			fmt.Sprintf("Failed to get graph schema: %s", err.Error()),
+		)
+		return
+	}
+
+	if graphSchema.Hash == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get graph schema",
+			fmt.Sprintf("Failed to get graph schema: %s because no schema was published on the variant.", req.ID),
+		)
+		return
+	}
+
+	var state GraphSchemaResourceModel
+	state.GraphId = types.StringValue(matchs[1])
+	state.VariantName = types.StringValue(matchs[2])
+	state.Schema = types.StringValue(graphSchema.Document)
+	state.Hash = types.StringValue(graphSchema.Hash)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
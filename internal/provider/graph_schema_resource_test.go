@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphSchemaResource(t *testing.T) {
+	randomId := uuid.New().String()[0:8]
+	graphId := fmt.Sprintf("test-%s", randomId)
+	graphConfig := providerConfig + `resource "apollostudio_graph" "this" {
+		id = "` + graphId + `"
+		name = "` + graphId + `"
+		description = "Test Graph"
+		graph_type = "CLASSIC"
+	}
+	`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: graphConfig + `resource "apollostudio_graph_schema" "this" {
+					graph_id = apollostudio_graph.this.id
+					variant_name = "current"
+					schema = "type Query { hello: String }"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_graph_schema.this", "graph_id", graphId),
+					resource.TestCheckResourceAttr("apollostudio_graph_schema.this", "variant_name", "current"),
+					resource.TestCheckResourceAttrSet("apollostudio_graph_schema.this", "hash"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:                         "apollostudio_graph_schema.this",
+				ImportState:                          true,
+				ImportStateId:                        graphId + "@current",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "graph_id",
+				ImportStateVerifyIgnore:              []string{"schema"},
+			},
+			// Update and read testing
+			{
+				Config: graphConfig + `resource "apollostudio_graph_schema" "this" {
+					graph_id = apollostudio_graph.this.id
+					variant_name = "current"
+					schema = "type Query { hello: String, world: String }"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_graph_schema.this", "schema", "type Query { hello: String, world: String }"),
+					resource.TestCheckResourceAttrSet("apollostudio_graph_schema.this", "hash"),
+				),
+			},
+			// Delete is automatically done by the framework
+		},
+	})
+}
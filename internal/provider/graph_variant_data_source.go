@@ -19,9 +19,25 @@ type GraphVariantDataSource struct {
 	client *client.ApolloClient
 }
 
+type GraphVariantPublicationModel struct {
+	Hash      types.String `tfsdk:"hash"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
 type GraphVariantDataSourceModel struct {
-	Id   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	Id                 types.String                  `tfsdk:"id"`
+	Name               types.String                  `tfsdk:"name"`
+	Url                types.String                  `tfsdk:"url"`
+	IsContract         types.Bool                    `tfsdk:"is_contract"`
+	SourceVariant      types.String                  `tfsdk:"source_variant"`
+	FederationVersion  types.String                  `tfsdk:"federation_version"`
+	IsProtected        types.Bool                    `tfsdk:"is_protected"`
+	IsPublic           types.Bool                    `tfsdk:"is_public"`
+	LatestPublication  *GraphVariantPublicationModel `tfsdk:"latest_publication"`
+	SubgraphCount      types.Int64                   `tfsdk:"subgraph_count"`
+	LatestLaunchId     types.String                  `tfsdk:"latest_launch_id"`
+	LatestLaunchStatus types.String                  `tfsdk:"latest_launch_status"`
+	RouterConfig       types.String                  `tfsdk:"router_config"`
 }
 
 func NewGraphVariantDataSource() datasource.DataSource {
@@ -51,6 +67,60 @@ func (d *GraphVariantDataSource) Schema(_ context.Context, req datasource.Schema
 				Description: "Name of the variant",
 				Computed:    true,
 			},
+			"url": schema.StringAttribute{
+				Description: "Routing URL of the variant, i.e. the URL clients send their operations to",
+				Computed:    true,
+			},
+			"is_contract": schema.BoolAttribute{
+				Description: "Boolean indicating if the variant is a contract variant",
+				Computed:    true,
+			},
+			"source_variant": schema.StringAttribute{
+				Description: "Name of the variant a contract variant is derived from, null for other variants",
+				Computed:    true,
+			},
+			"federation_version": schema.StringAttribute{
+				Description: "Federation version used to compose the supergraph of the variant (e.g. `2.3`), null for a non-federated variant",
+				Computed:    true,
+			},
+			"is_protected": schema.BoolAttribute{
+				Description: "Boolean indicating if the variant is protected, i.e. only graph admins can publish to it",
+				Computed:    true,
+			},
+			"is_public": schema.BoolAttribute{
+				Description: "Boolean indicating if the variant is visible to anyone, including users outside of the organization",
+				Computed:    true,
+			},
+			"latest_publication": schema.SingleNestedAttribute{
+				Description: "Provide details about the latest schema publication of the variant, null when nothing was ever published",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"hash": schema.StringAttribute{
+						Description: "Hash of the published schema",
+						Computed:    true,
+					},
+					"created_at": schema.StringAttribute{
+						Description: "Publication date of the schema",
+						Computed:    true,
+					},
+				},
+			},
+			"subgraph_count": schema.Int64Attribute{
+				Description: "Number of subgraphs of the variant, 0 for a non-federated variant",
+				Computed:    true,
+			},
+			"latest_launch_id": schema.StringAttribute{
+				Description: "ID of the latest launch of the variant, null when the variant was never launched",
+				Computed:    true,
+			},
+			"latest_launch_status": schema.StringAttribute{
+				Description: "Status of the latest launch of the variant. This can be one of: `LAUNCH_INITIATED`, `LAUNCH_COMPLETED`, `LAUNCH_FAILED`. Null when the variant was never launched",
+				Computed:    true,
+			},
+			"router_config": schema.StringAttribute{
+				Description: "YAML configuration of the router of a cloud variant, null for other variants",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -82,7 +152,7 @@ func (d *GraphVariantDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	graphVariant, err := d.client.GetGraphVariant(ctx, data.Id.ValueString())
+	graphVariant, err := d.client.GetGraphVariantDetails(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to get graph variant",
@@ -102,6 +172,36 @@ func (d *GraphVariantDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	data.Id = types.StringValue(graphVariant.Id)
 	data.Name = types.StringValue(graphVariant.Name)
+	data.Url = types.StringValue(graphVariant.Url)
+	data.IsContract = types.BoolValue(graphVariant.IsContract)
+	data.SourceVariant = types.StringNull()
+	if graphVariant.SourceVariant.Name != "" {
+		data.SourceVariant = types.StringValue(graphVariant.SourceVariant.Name)
+	}
+	data.FederationVersion = types.StringNull()
+	if graphVariant.FederationVersion != "" {
+		data.FederationVersion = types.StringValue(graphVariant.FederationVersion)
+	}
+	data.IsProtected = types.BoolValue(graphVariant.IsProtected)
+	data.IsPublic = types.BoolValue(graphVariant.IsPublic)
+	data.LatestPublication = nil
+	if graphVariant.LatestPublication.Schema.Hash != "" {
+		data.LatestPublication = &GraphVariantPublicationModel{
+			Hash:      types.StringValue(graphVariant.LatestPublication.Schema.Hash),
+			CreatedAt: types.StringValue(graphVariant.LatestPublication.PublishedAt),
+		}
+	}
+	data.SubgraphCount = types.Int64Value(int64(len(graphVariant.Subgraphs)))
+	data.LatestLaunchId = types.StringNull()
+	data.LatestLaunchStatus = types.StringNull()
+	if graphVariant.LatestLaunch.Id != "" {
+		data.LatestLaunchId = types.StringValue(graphVariant.LatestLaunch.Id)
+		data.LatestLaunchStatus = types.StringValue(string(graphVariant.LatestLaunch.Status))
+	}
+	data.RouterConfig = types.StringNull()
+	if graphVariant.RouterConfig != "" {
+		data.RouterConfig = types.StringValue(graphVariant.RouterConfig)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -18,6 +18,10 @@ func TestAccGraphVariantDataSource(t *testing.T) {
 				`,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.apollostudio_graph_variant.this", "name", "current"),
+					resource.TestCheckResourceAttr("data.apollostudio_graph_variant.this", "is_contract", "false"),
+					resource.TestCheckNoResourceAttr("data.apollostudio_graph_variant.this", "source_variant"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_graph_variant.this", "subgraph_count"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_graph_variant.this", "latest_publication.hash"),
 				),
 			},
 		},
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
@@ -17,9 +18,51 @@ type GraphVariantsDataSource struct {
 	client *client.ApolloClient
 }
 
+type GraphVariantsItemModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Url               types.String `tfsdk:"url"`
+	LatestPublishedAt types.String `tfsdk:"latest_published_at"`
+}
+
 type GraphVariantsDataSourceModel struct {
-	GraphId       types.String                  `tfsdk:"graph_id"`
-	GraphVariants []GraphVariantDataSourceModel `tfsdk:"variants"`
+	GraphId       types.String             `tfsdk:"graph_id"`
+	NameRegex     types.String             `tfsdk:"name_regex"`
+	GraphVariants []GraphVariantsItemModel `tfsdk:"variants"`
+}
+
+// graphVariantsItemAttributes are the attributes of a variant in a list of variants.
+var graphVariantsItemAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description: "ID of the variant",
+		Computed:    true,
+	},
+	"name": schema.StringAttribute{
+		Description: "Name of the variant",
+		Computed:    true,
+	},
+	"url": schema.StringAttribute{
+		Description: "Routing URL of the variant",
+		Computed:    true,
+	},
+	"latest_published_at": schema.StringAttribute{
+		Description: "Date of the latest schema publication of the variant, null when nothing was ever published",
+		Computed:    true,
+	},
+}
+
+// graphVariantsItemModel maps a variant to an item of a list of variants.
+func graphVariantsItemModel(graphVariant client.GraphVariant) GraphVariantsItemModel {
+	latestPublishedAt := types.StringNull()
+	if graphVariant.LatestPublication.PublishedAt != "" {
+		latestPublishedAt = types.StringValue(graphVariant.LatestPublication.PublishedAt)
+	}
+	return GraphVariantsItemModel{
+		Id:                types.StringValue(graphVariant.Id),
+		Name:              types.StringValue(graphVariant.Name),
+		Url:               types.StringValue(graphVariant.Url),
+		LatestPublishedAt: latestPublishedAt,
+	}
 }
 
 func NewGraphVariantsDataSource() datasource.DataSource {
@@ -38,20 +81,15 @@ func (d *GraphVariantsDataSource) Schema(_ context.Context, req datasource.Schem
 				Description: "ID of the graph",
 				Required:    true,
 			},
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression the name of the returned variants must match",
+				Optional:    true,
+			},
 			"variants": schema.ListNestedAttribute{
 				Description: "List of graph variants",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Description: "ID of the variant",
-							Computed:    true,
-						},
-						"name": schema.StringAttribute{
-							Description: "Name of the variant",
-							Computed:    true,
-						},
-					},
+					Attributes: graphVariantsItemAttributes,
 				},
 			},
 		},
@@ -85,6 +123,11 @@ func (d *GraphVariantsDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	nameRegex := regexFilter(data.NameRegex, path.Root("name_regex"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Warn(ctx, "Reading graph variants for graph: "+data.GraphId.ValueString())
 
 	graphVariants, err := d.client.GetGraphVariants(ctx, data.GraphId.ValueString())
@@ -97,10 +140,10 @@ func (d *GraphVariantsDataSource) Read(ctx context.Context, req datasource.ReadR
 	}
 
 	for _, graphVariant := range graphVariants {
-		data.GraphVariants = append(data.GraphVariants, GraphVariantDataSourceModel{
-			Id:   types.StringValue(graphVariant.Id),
-			Name: types.StringValue(graphVariant.Name),
-		})
+		if nameRegex != nil && !nameRegex.MatchString(graphVariant.Name) {
+			continue
+		}
+		data.GraphVariants = append(data.GraphVariants, graphVariantsItemModel(graphVariant))
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
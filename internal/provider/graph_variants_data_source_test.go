@@ -20,6 +20,17 @@ func TestAccGraphVariantsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.apollostudio_graph_variants.this", "variants.#", "1"),
 				),
 			},
+			{
+				Config: providerConfig + `
+					data "apollostudio_graph_variants" "this" {
+						graph_id   = "testacc-terraform"
+						name_regex = "^unknown-"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.apollostudio_graph_variants.this", "variants.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
 )
@@ -16,8 +19,21 @@ type GraphsDataSource struct {
 	client *client.ApolloClient
 }
 
+type GraphsItemModel struct {
+	Id               types.String             `tfsdk:"id"`
+	Name             types.String             `tfsdk:"name"`
+	Description      types.String             `tfsdk:"description"`
+	GraphType        types.String             `tfsdk:"graph_type"`
+	ReportingEnabled types.Bool               `tfsdk:"reporting_enabled"`
+	Variants         []GraphVariantsItemModel `tfsdk:"variants"`
+}
+
 type GraphsDataSourceModel struct {
-	Graphs []GraphDataSourceModel `tfsdk:"graphs"`
+	IdRegex         types.String      `tfsdk:"id_regex"`
+	NameRegex       types.String      `tfsdk:"name_regex"`
+	GraphType       types.String      `tfsdk:"graph_type"`
+	IncludeVariants types.Bool        `tfsdk:"include_variants"`
+	Graphs          []GraphsItemModel `tfsdk:"graphs"`
 }
 
 func NewGraphsDataSource() datasource.DataSource {
@@ -32,6 +48,29 @@ func (d *GraphsDataSource) Schema(_ context.Context, req datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		Description: "Provide details about a specific organization's graphs",
 		Attributes: map[string]schema.Attribute{
+			"id_regex": schema.StringAttribute{
+				Description: "Regular expression the ID of the returned graphs must match",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression the name of the returned graphs must match",
+				Optional:    true,
+			},
+			"graph_type": schema.StringAttribute{
+				Description: "Type of the returned graphs. This can be one of: `CLASSIC`, `CLOUD_SUPERGRAPH`, `SELF_HOSTED_SUPERGRAPH`",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.GraphTypeClassic),
+						string(client.GraphTypeCloudSupergraph),
+						string(client.GraphTypeSelfHostedSupergraph),
+					),
+				},
+			},
+			"include_variants": schema.BoolAttribute{
+				Description: "Boolean indicating if the variants of each graph should be included in the result",
+				Optional:    true,
+			},
 			"graphs": schema.ListNestedAttribute{
 				Description: "List of graphs",
 				Computed:    true,
@@ -50,13 +89,20 @@ func (d *GraphsDataSource) Schema(_ context.Context, req datasource.SchemaReques
 							Computed:    true,
 						},
 						"graph_type": schema.StringAttribute{
-							Description: "Type of the graph. This can be one of: `CLASSIC`, `CLOUD_SUPERGRAPH`",
+							Description: "Type of the graph. This can be one of: `CLASSIC`, `CLOUD_SUPERGRAPH`, `SELF_HOSTED_SUPERGRAPH`",
 							Computed:    true,
 						},
 						"reporting_enabled": schema.BoolAttribute{
 							Description: "Boolean indicating if reporting is enabled for the graph",
 							Computed:    true,
 						},
+						"variants": schema.ListNestedAttribute{
+							Description: "List of graph variants, only set when `include_variants` is true",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: graphVariantsItemAttributes,
+							},
+						},
 					},
 				},
 			},
@@ -91,7 +137,24 @@ func (d *GraphsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	graphs, err := d.client.GetGraphs(ctx)
+	idRegex := regexFilter(data.IdRegex, path.Root("id_regex"), &resp.Diagnostics)
+	nameRegex := regexFilter(data.NameRegex, path.Root("name_regex"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Variants are only queried when requested
+	var graphs []client.GraphWithVariants
+	var err error
+	if data.IncludeVariants.ValueBool() {
+		graphs, err = d.client.GetGraphsWithVariants(ctx)
+	} else {
+		var graphsWithoutVariants []client.Graph
+		graphsWithoutVariants, err = d.client.GetGraphs(ctx)
+		for _, graph := range graphsWithoutVariants {
+			graphs = append(graphs, client.GraphWithVariants{Graph: graph})
+		}
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to get graphs",
@@ -101,12 +164,31 @@ func (d *GraphsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	for _, graph := range graphs {
-		data.Graphs = append(data.Graphs, GraphDataSourceModel{
+		if idRegex != nil && !idRegex.MatchString(graph.Id) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(graph.Name) {
+			continue
+		}
+		if !data.GraphType.IsNull() && graph.GraphType != data.GraphType.ValueString() {
+			continue
+		}
+
+		var variants []GraphVariantsItemModel
+		if data.IncludeVariants.ValueBool() {
+			variants = make([]GraphVariantsItemModel, 0, len(graph.Variants))
+			for _, graphVariant := range graph.Variants {
+				variants = append(variants, graphVariantsItemModel(graphVariant))
+			}
+		}
+
+		data.Graphs = append(data.Graphs, GraphsItemModel{
 			Id:               types.StringValue(graph.Id),
 			Name:             types.StringValue(graph.Name),
 			Description:      types.StringValue(graph.Description),
 			GraphType:        types.StringValue(graph.GraphType),
 			ReportingEnabled: types.BoolValue(graph.ReportingEnabled),
+			Variants:         variants,
 		})
 	}
 
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "apollostudio_graphs" "this" {
+						id_regex         = "^testacc-terraform$"
+						include_variants = true
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.apollostudio_graphs.this", "graphs.#", "1"),
+					resource.TestCheckResourceAttr("data.apollostudio_graphs.this", "graphs.0.id", "testacc-terraform"),
+					resource.TestCheckResourceAttr("data.apollostudio_graphs.this", "graphs.0.variants.0.name", "current"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource                   = &NotificationChannelResource{}
+	_ resource.ResourceWithConfigure      = &NotificationChannelResource{}
+	_ resource.ResourceWithImportState    = &NotificationChannelResource{}
+	_ resource.ResourceWithValidateConfig = &NotificationChannelResource{}
+)
+
+type NotificationChannelResource struct {
+	client *client.ApolloClient
+}
+
+type NotificationChannelResourceModel struct {
+	GraphId     types.String `tfsdk:"graph_id"`
+	Id          types.String `tfsdk:"id"`
+	Type        types.String `tfsdk:"type"`
+	Name        types.String `tfsdk:"name"`
+	Url         types.String `tfsdk:"url"`
+	SecretToken types.String `tfsdk:"secret_token"`
+	Email       types.String `tfsdk:"email"`
+}
+
+func NewNotificationChannelResource() resource.Resource {
+	return &NotificationChannelResource{}
+}
+
+func (r *NotificationChannelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+func (r *NotificationChannelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a notification channel of a graph, used to send schema change and launch notifications",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the notification channel",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the notification channel. This can be one of: `WEBHOOK`, `SLACK`, `EMAIL`",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.NotificationChannelTypeWebhook),
+						string(client.NotificationChannelTypeSlack),
+						string(client.NotificationChannelTypeEmail),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the notification channel",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "URL notifications are sent to: the endpoint of a `WEBHOOK` channel or the incoming webhook URL of a `SLACK` channel",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^https?://`),
+						"must be an HTTP(S) URL",
+					),
+				},
+			},
+			"secret_token": schema.StringAttribute{
+				Description: "Token used to sign the payloads sent to a `WEBHOOK` channel. It can't be read back, so changes made outside of Terraform aren't detected",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address notifications are sent to, for an `EMAIL` channel",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *NotificationChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NotificationChannelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config NotificationChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() {
+		return
+	}
+
+	// Attributes required and allowed by each type of channel
+	channelType := client.NotificationChannelType(config.Type.ValueString())
+	attributes := map[string]types.String{
+		"url":          config.Url,
+		"secret_token": config.SecretToken,
+		"email":        config.Email,
+	}
+	required := map[client.NotificationChannelType][]string{
+		client.NotificationChannelTypeWebhook: {"url"},
+		client.NotificationChannelTypeSlack:   {"url"},
+		client.NotificationChannelTypeEmail:   {"email"},
+	}
+	allowed := map[client.NotificationChannelType][]string{
+		client.NotificationChannelTypeWebhook: {"url", "secret_token"},
+		client.NotificationChannelTypeSlack:   {"url"},
+		client.NotificationChannelTypeEmail:   {"email"},
+	}
+
+	for _, name := range required[channelType] {
+		if attributes[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing notification channel attribute",
+				fmt.Sprintf("%s is required for a %s notification channel", name, channelType),
+			)
+		}
+	}
+	for name, value := range attributes {
+		isAllowed := false
+		for _, allowedName := range allowed[channelType] {
+			if name == allowedName {
+				isAllowed = true
+			}
+		}
+		if !isAllowed && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unexpected notification channel attribute",
+				fmt.Sprintf("%s can't be set for a %s notification channel", name, channelType),
+			)
+		}
+	}
+}
+
+// notificationChannelInput builds the input sent to the API from the model.
+func notificationChannelInput(model NotificationChannelResourceModel) client.NotificationChannelInput {
+	return client.NotificationChannelInput{
+		Name:        model.Name.ValueString(),
+		Url:         model.Url.ValueString(),
+		SecretToken: model.SecretToken.ValueString(),
+		Email:       model.Email.ValueString(),
+	}
+}
+
+func (r *NotificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan NotificationChannelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the channel
+	channel, err := r.client.CreateNotificationChannel(ctx, plan.GraphId.ValueString(), client.NotificationChannelType(plan.Type.ValueString()), notificationChannelInput(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create notification channel",
+			fmt.Sprintf("Failed to create notification channel: %s", err.Error()),
+		)
+		return
+	}
+
+	// GraphQL API does not return an error when the channel creation fails
+	if channel.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to create notification channel",
+			fmt.Sprintf("Failed to create notification channel on graph %s, unexpected error. Might be the API key used to configure the provider does not have the right permissions on the graph.", plan.GraphId.ValueString()),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(channel.Id)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *NotificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Return values from state
+	var state NotificationChannelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the channel
+	channel, err := r.client.GetNotificationChannel(ctx, state.GraphId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get notification channel",
+			fmt.Sprintf("Failed to get notification channel: %s", err.Error()),
+		)
+		return
+	}
+
+	if channel.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get notification channel",
+			fmt.Sprintf("Failed to get notification channel: %s because the channel wasn't found. Might have been deleted", state.Id.ValueString()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate response
+	state.Type = types.StringValue(string(channel.Type()))
+	state.Name = types.StringValue(channel.Name)
+	switch channel.Type() {
+	case client.NotificationChannelTypeSlack:
+		state.Url = types.StringValue(channel.SlackChannel.Url)
+	case client.NotificationChannelTypeWebhook:
+		state.Url = types.StringValue(channel.WebhookChannel.Url)
+	case client.NotificationChannelTypeEmail:
+		state.Email = types.StringValue(channel.EmailChannel.Email)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *NotificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Return values from plan
+	var plan NotificationChannelResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Return values from state
+	var state NotificationChannelResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the channel
+	_, err := r.client.UpdateNotificationChannel(ctx, state.GraphId.ValueString(), state.Id.ValueString(), client.NotificationChannelType(state.Type.ValueString()), notificationChannelInput(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update notification channel",
+			fmt.Sprintf("Failed to update notification channel: %s", err.Error()),
+		)
+		return
+	}
+
+	// Saved updated values to state
+	plan.Id = state.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *NotificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Return values from state
+	var state NotificationChannelResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the channel
+	err := r.client.DeleteNotificationChannel(ctx, state.GraphId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete notification channel",
+			fmt.Sprintf("Failed to delete notification channel: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (r *NotificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, channelId, found := strings.Cut(req.ID, ":")
+	if !found || graphId == "" || channelId == "" {
+		resp.Diagnostics.AddError(
+			"Invalid notification channel ID",
+			fmt.Sprintf("Invalid notification channel ID: %s, expected <graph-id>:<channel-id>", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), channelId)...)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNotificationChannelResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_notification_channel" "this" {
+					graph_id = "testacc-terraform"
+					type     = "WEBHOOK"
+					name     = "testacc"
+					email    = "testacc@example.com"
+				}`,
+				ExpectError: regexp.MustCompile("Missing notification channel attribute"),
+			},
+			{
+				Config: providerConfig + `resource "apollostudio_notification_channel" "this" {
+					graph_id = "testacc-terraform"
+					type     = "WEBHOOK"
+					name     = "testacc"
+					url      = "https://example.com/testacc"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollostudio_notification_channel.this", "id"),
+					resource.TestCheckResourceAttr("apollostudio_notification_channel.this", "type", "WEBHOOK"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "apollostudio_notification_channel.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["apollostudio_notification_channel.this"]
+					return rs.Primary.Attributes["graph_id"] + ":" + rs.Primary.Attributes["id"], nil
+				},
+			},
+			// Update and read testing
+			{
+				Config: providerConfig + `resource "apollostudio_notification_channel" "this" {
+					graph_id = "testacc-terraform"
+					type     = "WEBHOOK"
+					name     = "testacc-updated"
+					url      = "https://example.com/testacc"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_notification_channel.this", "name", "testacc-updated"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource                   = &OperationCheckOverrideResource{}
+	_ resource.ResourceWithConfigure      = &OperationCheckOverrideResource{}
+	_ resource.ResourceWithImportState    = &OperationCheckOverrideResource{}
+	_ resource.ResourceWithValidateConfig = &OperationCheckOverrideResource{}
+)
+
+const (
+	operationCheckOverrideMarkSafe = "MARK_SAFE"
+	operationCheckOverrideIgnore   = "IGNORE"
+)
+
+type OperationCheckOverrideResource struct {
+	client *client.ApolloClient
+}
+
+type OperationCheckOverrideResourceModel struct {
+	GraphId     types.String `tfsdk:"graph_id"`
+	OperationId types.String `tfsdk:"operation_id"`
+	Action      types.String `tfsdk:"action"`
+	CheckId     types.String `tfsdk:"check_id"`
+	Name        types.String `tfsdk:"name"`
+	Signature   types.String `tfsdk:"signature"`
+}
+
+func NewOperationCheckOverrideResource() resource.Resource {
+	return &OperationCheckOverrideResource{}
+}
+
+func (r *OperationCheckOverrideResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operation_check_override"
+}
+
+func (r *OperationCheckOverrideResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Override the outcome of the operation checks of a graph for a specific operation, either by marking the changes found by a check as safe or by ignoring the operation in future checks. " +
+			"The `IGNORE` overrides and the `excluded_operations` of `apollostudio_graph_check_config` manage the same list of ignored operations, only use one of them for a graph",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"operation_id": schema.StringAttribute{
+				Description: "ID of the operation, as listed in the affected operations of a check",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "Override applied to the operation. This can be one of: `MARK_SAFE` (the changes found by `check_id` are approved for the operation), `IGNORE` (the operation is ignored in future checks)",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(operationCheckOverrideMarkSafe, operationCheckOverrideIgnore),
+				},
+			},
+			"check_id": schema.StringAttribute{
+				Description: "ID of the check whose changes are marked as safe. Required when `action` is `MARK_SAFE`",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the operation whose changes were marked as safe",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signature": schema.StringAttribute{
+				Description: "Signature of the operation whose changes were marked as safe",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OperationCheckOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OperationCheckOverrideResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config OperationCheckOverrideResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Action.IsUnknown() || config.CheckId.IsUnknown() {
+		return
+	}
+
+	if config.Action.ValueString() == operationCheckOverrideMarkSafe && config.CheckId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("check_id"),
+			"Missing check ID",
+			"check_id is required to mark the changes of an operation as safe",
+		)
+	}
+	if config.Action.ValueString() == operationCheckOverrideIgnore && !config.CheckId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("check_id"),
+			"Unexpected check ID",
+			"check_id can only be set to mark the changes of an operation as safe",
+		)
+	}
+}
+
+func (r *OperationCheckOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan OperationCheckOverrideResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Action.ValueString() == operationCheckOverrideMarkSafe {
+		// Mark the changes as safe
+		operation, err := r.client.MarkChangesForOperationAsSafe(ctx, plan.GraphId.ValueString(), plan.CheckId.ValueString(), plan.OperationId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to mark operation changes as safe",
+				fmt.Sprintf("Failed to mark operation changes as safe: %s", err.Error()),
+			)
+			return
+		}
+		plan.Name = types.StringValue(operation.DisplayName)
+		plan.Signature = types.StringValue(operation.Signature)
+	} else {
+		// Ignore the operation
+		err := r.client.IgnoreOperationsInChecks(ctx, plan.GraphId.ValueString(), []string{plan.OperationId.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to ignore operation in checks",
+				fmt.Sprintf("Failed to ignore operation in checks: %s", err.Error()),
+			)
+			return
+		}
+		plan.Name = types.StringNull()
+		plan.Signature = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OperationCheckOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Return values from state
+	var state OperationCheckOverrideResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Approved changes can't be read back, only ignored operations are
+	if state.Action.ValueString() == operationCheckOverrideIgnore {
+		config, err := r.client.GetCheckConfiguration(ctx, state.GraphId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to get graph check configuration",
+				fmt.Sprintf("Failed to get graph check configuration: %s", err.Error()),
+			)
+			return
+		}
+
+		ignored := false
+		for _, operation := range config.ExcludedOperations {
+			if operation.Id == state.OperationId.ValueString() {
+				ignored = true
+			}
+		}
+		if !ignored {
+			resp.Diagnostics.AddError(
+				"Failed to get ignored operation",
+				fmt.Sprintf("Failed to get ignored operation: %s because the operation isn't ignored anymore. Might have been removed", state.OperationId.ValueString()),
+			)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OperationCheckOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Return values from plan
+	var plan OperationCheckOverrideResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute requires a replacement, nothing to update
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OperationCheckOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Return values from state
+	var state OperationCheckOverrideResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Action.ValueString() == operationCheckOverrideMarkSafe {
+		// Unmark the changes
+		err := r.client.UnmarkChangesForOperationAsSafe(ctx, state.GraphId.ValueString(), state.CheckId.ValueString(), state.OperationId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to unmark operation changes as safe",
+				fmt.Sprintf("Failed to unmark operation changes as safe: %s", err.Error()),
+			)
+			return
+		}
+		return
+	}
+
+	// Stop ignoring the operation
+	err := r.client.UnignoreOperationsInChecks(ctx, state.GraphId.ValueString(), []string{state.OperationId.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to unignore operation in checks",
+			fmt.Sprintf("Failed to unignore operation in checks: %s", err.Error()),
+		)
+		return
+	}
+}
+
+// ImportState reads the action from the ID. Changes marked as safe can't be read back, so their name and signature are left empty.
+func (r *OperationCheckOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state OperationCheckOverrideResourceModel
+	state.CheckId = types.StringNull()
+	state.Name = types.StringNull()
+	state.Signature = types.StringNull()
+
+	parts := strings.Split(req.ID, ":")
+	switch {
+	case len(parts) == 3 && parts[1] == operationCheckOverrideIgnore && parts[0] != "" && parts[2] != "":
+		state.OperationId = types.StringValue(parts[2])
+	case len(parts) == 4 && parts[1] == operationCheckOverrideMarkSafe && parts[0] != "" && parts[2] != "" && parts[3] != "":
+		state.CheckId = types.StringValue(parts[2])
+		state.OperationId = types.StringValue(parts[3])
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid operation check override ID",
+			fmt.Sprintf("Invalid operation check override ID: %s, expected <graph-id>:IGNORE:<operation-id> or <graph-id>:MARK_SAFE:<check-id>:<operation-id>", req.ID),
+		)
+		return
+	}
+	state.GraphId = types.StringValue(parts[0])
+	state.Action = types.StringValue(parts[1])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOperationCheckOverrideResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_operation_check_override" "this" {
+					graph_id     = "testacc-terraform"
+					operation_id = "testacc-operation"
+					action       = "MARK_SAFE"
+				}`,
+				ExpectError: regexp.MustCompile("Missing check ID"),
+			},
+			{
+				Config: providerConfig + `resource "apollostudio_operation_check_override" "this" {
+					graph_id     = "testacc-terraform"
+					operation_id = "testacc-operation"
+					action       = "IGNORE"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_operation_check_override.this", "action", "IGNORE"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:                         "apollostudio_operation_check_override.this",
+				ImportState:                          true,
+				ImportStateId:                        "testacc-terraform:IGNORE:testacc-operation",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "operation_id",
+			},
+		},
+	})
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource                = &OrganizationApiKeyResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationApiKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &OrganizationApiKeyResource{}
+	_ resource.ResourceWithImportState = &OrganizationApiKeyResource{}
+)
+
+type OrganizationApiKeyResource struct {
+	apiKeyResource
+}
+
+type OrganizationApiKeyResourceModel struct {
+	Role types.String `tfsdk:"role"`
+	ApiKeyResourceModel
+}
+
+func (m *OrganizationApiKeyResourceModel) refresh(key apiKey) {
+	m.ApiKeyResourceModel.refresh(key)
+	m.Role = types.StringValue(key.Role)
+}
+
+func NewOrganizationApiKeyResource() resource.Resource {
+	r := &OrganizationApiKeyResource{}
+	r.apiKeyResource = apiKeyResource{owner: r, kind: "org api key"}
+	return r
+}
+
+func (r *OrganizationApiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_api_key"
+}
+
+func (r *OrganizationApiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an API key of the current organization, granting its role on every graph of the organization",
+		Attributes: withApiKeyRotationAttributes(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the API key",
+				Computed:    true,
+			},
+			"key_name": schema.StringAttribute{
+				Description: "Name of the API key",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role of the API key. This role can be either `ORG_ADMIN`, `GRAPH_ADMIN`, `CONTRIBUTOR`, `DOCUMENTER`, `OBSERVER` or `CONSUMER`. Changing the role creates a new API key",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.UserPermissionOrgAdmin),
+						string(client.UserPermissionGraphAdmin),
+						string(client.UserPermissionContributor),
+						string(client.UserPermissionDocumenter),
+						string(client.UserPermissionObserver),
+						string(client.UserPermissionConsumer),
+					),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "Authentication token of the API key. This value is only fully available when creating the API key, the current value is partially masked. An imported API key only has the masked value",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation date of the API key",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+func (r *OrganizationApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan OrganizationApiKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the API key
+	apiKey, err := r.client.CreateOrganizationApiKey(ctx, plan.KeyName.ValueString(), client.UserPermission(plan.Role.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating org api key",
+			"Could not create org api key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// GraphQL API does not return an error when the API key creation fails
+	if apiKey.Id == "" {
+		resp.Diagnostics.AddError(
+			"Error creating org api key",
+			"Could not create org api key, unexpected error. Might be the API key used to configure the provider does not have the right permissions to create API key on the organization.",
+		)
+		return
+	}
+
+	// Map response body to schema and populate response
+	plan.Id = types.StringValue(apiKey.Id)
+	plan.Role = types.StringValue(apiKey.Role)
+	plan.Token = types.StringValue(apiKey.Token)
+	plan.CreatedAt = types.StringValue(apiKey.CreatedAt)
+
+	// Compute when the API key must be rotated
+	plan.ExpiresAt, err = apiKeyExpiresAt(plan.RotationPeriod)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating org api key",
+			"Could not parse rotation period, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OrganizationApiKeyResource) newApiKeyModel() apiKeyResourceModel {
+	return &OrganizationApiKeyResourceModel{}
+}
+
+// importApiKeyModel has nothing to parse, the organization is the one of the provider.
+func (r *OrganizationApiKeyResource) importApiKeyModel(_ context.Context, id string) (apiKeyResourceModel, string, diag.Diagnostics) {
+	return &OrganizationApiKeyResourceModel{}, id, nil
+}
+
+func (r *OrganizationApiKeyResource) getApiKeys(ctx context.Context, _ apiKeyResourceModel) ([]apiKey, error) {
+	orgApiKeys, err := r.client.GetOrganizationApiKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiKeys := make([]apiKey, 0, len(orgApiKeys))
+	for _, ak := range orgApiKeys {
+		apiKeys = append(apiKeys, apiKey(ak))
+	}
+	return apiKeys, nil
+}
+
+func (r *OrganizationApiKeyResource) renameApiKey(ctx context.Context, _ apiKeyResourceModel, apiKeyId string, keyName string) error {
+	return r.client.RenameOrganizationApiKey(ctx, apiKeyId, keyName)
+}
+
+func (r *OrganizationApiKeyResource) removeApiKey(ctx context.Context, _ apiKeyResourceModel, apiKeyId string) error {
+	return r.client.RemoveOrganizationApiKey(ctx, apiKeyId)
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrgApiKeyResource(t *testing.T) {
+	randomId := uuid.New().String()[0:8]
+	keyName := fmt.Sprintf("test-%s", randomId)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_org_api_key" "this" {
+					key_name = "` + keyName + `"
+					role     = "CONSUMER"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_org_api_key.this", "key_name", keyName),
+					resource.TestCheckResourceAttr("apollostudio_org_api_key.this", "role", "CONSUMER"),
+					resource.TestCheckResourceAttrSet("apollostudio_org_api_key.this", "token"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:            "apollostudio_org_api_key.this",
+				ImportState:             true,
+				ImportStateId:           keyName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Rename and rotation with a grace period
+			{
+				Config: providerConfig + `resource "apollostudio_org_api_key" "this" {
+					key_name        = "` + keyName + `-renamed"
+					role            = "CONSUMER"
+					rotation_period = "720h"
+					grace_period    = "1s"
+
+					lifecycle {
+						create_before_destroy = true
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_org_api_key.this", "key_name", keyName+"-renamed"),
+					resource.TestCheckResourceAttrSet("apollostudio_org_api_key.this", "expires_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var _ datasource.DataSource = &OrganizationApiKeysDataSource{}
+
+type OrganizationApiKeysDataSource struct {
+	client *client.ApolloClient
+}
+
+type OrganizationApiKeyDataSourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	KeyName   types.String `tfsdk:"key_name"`
+	Role      types.String `tfsdk:"role"`
+	Token     types.String `tfsdk:"token"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+type OrganizationApiKeysDataSourceModel struct {
+	ApiKeys []OrganizationApiKeyDataSourceModel `tfsdk:"api_keys"`
+}
+
+func NewOrganizationApiKeysDataSource() datasource.DataSource {
+	return &OrganizationApiKeysDataSource{}
+}
+
+func (d *OrganizationApiKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_api_keys"
+}
+
+func (d *OrganizationApiKeysDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provide details about the API keys of the current organization. Beware that the API key token is partially masked when read, it's only available at creation time.",
+		Attributes: map[string]schema.Attribute{
+			"api_keys": schema.ListNestedAttribute{
+				Description: "List of API keys",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the API key",
+							Computed:    true,
+						},
+						"key_name": schema.StringAttribute{
+							Description: "Name of the API key",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role of the API key. This role can be either `ORG_ADMIN`, `GRAPH_ADMIN`, `CONTRIBUTOR`, `DOCUMENTER`, `OBSERVER` or `CONSUMER`",
+							Computed:    true,
+						},
+						"token": schema.StringAttribute{
+							Description: "Authentication token of the API key. This value is only fully available when creating the API key, the current value is partially masked",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Creation date of the API key",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationApiKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationApiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationApiKeysDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKeys, err := d.client.GetOrganizationApiKeys(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get org api keys",
+			fmt.Sprintf("Failed to get org api keys: %s", err.Error()),
+		)
+		return
+	}
+
+	for _, apiKey := range apiKeys {
+		data.ApiKeys = append(data.ApiKeys, OrganizationApiKeyDataSourceModel{
+			Id:        types.StringValue(apiKey.Id),
+			KeyName:   types.StringValue(apiKey.KeyName),
+			Role:      types.StringValue(apiKey.Role),
+			Token:     types.StringValue(apiKey.Token),
+			CreatedAt: types.StringValue(apiKey.CreatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource                = &OrganizationInvitationResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationInvitationResource{}
+	_ resource.ResourceWithImportState = &OrganizationInvitationResource{}
+)
+
+type OrganizationInvitationResource struct {
+	client *client.ApolloClient
+}
+
+type OrganizationInvitationResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	Role      types.String `tfsdk:"role"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Accepted  types.Bool   `tfsdk:"accepted"`
+}
+
+func NewOrganizationInvitationResource() resource.Resource {
+	return &OrganizationInvitationResource{}
+}
+
+func (r *OrganizationInvitationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_invitation"
+}
+
+func (r *OrganizationInvitationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an invitation to join the current organization. Once accepted, the invitation is kept in the state and destroying it doesn't remove the member, use `apollostudio_org_member_role` for that",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the invitation",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Email of the invited user",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role granted to the user once the invitation is accepted. This can be one of: `ORG_ADMIN`, `GRAPH_ADMIN`, `CONTRIBUTOR`, `DOCUMENTER`, `OBSERVER`, `CONSUMER`, `BILLING_MANAGER`",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					orgRoleValidator,
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation date of the invitation",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"accepted": schema.BoolAttribute{
+				Description: "Whether the invitation was accepted",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *OrganizationInvitationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationInvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan OrganizationInvitationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Invite the user
+	invitation, err := r.client.InviteOrganizationMember(ctx, plan.Email.ValueString(), client.UserPermission(plan.Role.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create organization invitation",
+			fmt.Sprintf("Failed to create organization invitation: %s", err.Error()),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(invitation.Id)
+	plan.CreatedAt = types.StringValue(invitation.CreatedAt)
+	plan.Accepted = types.BoolValue(invitation.AcceptedAt != "")
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OrganizationInvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Return values from state
+	var state OrganizationInvitationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An accepted invitation isn't listed anymore, there is nothing left to refresh
+	if state.Accepted.ValueBool() {
+		return
+	}
+
+	// Get the invitation
+	invitation, err := r.client.GetOrganizationInvitation(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get organization invitation",
+			fmt.Sprintf("Failed to get organization invitation: %s", err.Error()),
+		)
+		return
+	}
+
+	if invitation.Id != "" {
+		// Map response body to schema and populate response
+		state.Email = types.StringValue(invitation.Email)
+		state.Role = types.StringValue(string(invitation.Role))
+		state.CreatedAt = types.StringValue(invitation.CreatedAt)
+		state.Accepted = types.BoolValue(invitation.AcceptedAt != "")
+	} else {
+		// The invitation is gone, it was accepted if the user is now a member
+		members, err := r.client.GetOrganizationMembers(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to get organization members",
+				fmt.Sprintf("Failed to get organization members: %s", err.Error()),
+			)
+			return
+		}
+
+		for _, member := range members {
+			if strings.EqualFold(member.User.Email, state.Email.ValueString()) {
+				state.Accepted = types.BoolValue(true)
+			}
+		}
+
+		if !state.Accepted.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Failed to get organization invitation",
+				fmt.Sprintf("Failed to get organization invitation: %s because the invitation wasn't found. Might have been deleted", state.Id.ValueString()),
+			)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OrganizationInvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All the configurable attributes require a replacement
+	resp.Diagnostics.AddError(
+		"Failed to update organization invitation",
+		"Failed to update organization invitation: invitations can't be updated",
+	)
+}
+
+func (r *OrganizationInvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Return values from state
+	var state OrganizationInvitationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The user already joined, removing them is done through apollostudio_org_member_role
+	if state.Accepted.ValueBool() {
+		return
+	}
+
+	// Remove the invitation
+	err := r.client.RemoveOrganizationInvitation(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete organization invitation",
+			fmt.Sprintf("Failed to delete organization invitation: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (r *OrganizationInvitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrgInvitationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_org_invitation" "this" {
+					email = "testacc@example.com"
+					role  = "OBSERVER"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollostudio_org_invitation.this", "id"),
+					resource.TestCheckResourceAttr("apollostudio_org_invitation.this", "accepted", "false"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:      "apollostudio_org_invitation.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Replace testing
+			{
+				Config: providerConfig + `resource "apollostudio_org_invitation" "this" {
+					email = "testacc@example.com"
+					role  = "CONSUMER"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_org_invitation.this", "role", "CONSUMER"),
+				),
+			},
+		},
+	})
+}
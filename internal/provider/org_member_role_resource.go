@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource                = &OrganizationMemberRoleResource{}
+	_ resource.ResourceWithConfigure   = &OrganizationMemberRoleResource{}
+	_ resource.ResourceWithImportState = &OrganizationMemberRoleResource{}
+)
+
+// orgRoleValidator validates the roles that can be granted to a member of the organization.
+var orgRoleValidator = stringvalidator.OneOf(
+	string(client.UserPermissionOrgAdmin),
+	string(client.UserPermissionGraphAdmin),
+	string(client.UserPermissionContributor),
+	string(client.UserPermissionDocumenter),
+	string(client.UserPermissionObserver),
+	string(client.UserPermissionConsumer),
+	string(client.UserPermissionBillingManager),
+)
+
+type OrganizationMemberRoleResource struct {
+	client *client.ApolloClient
+}
+
+type OrganizationMemberRoleResourceModel struct {
+	UserId types.String `tfsdk:"user_id"`
+	Role   types.String `tfsdk:"role"`
+}
+
+func NewOrganizationMemberRoleResource() resource.Resource {
+	return &OrganizationMemberRoleResource{}
+}
+
+func (r *OrganizationMemberRoleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_member_role"
+}
+
+func (r *OrganizationMemberRoleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the role of a member of the current organization. The user must already be a member: creating this resource adopts the existing membership and overwrites its role. Destroying this resource removes the member from the organization",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Description: "ID of the user",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role of the user in the organization. This can be one of: `ORG_ADMIN`, `GRAPH_ADMIN`, `CONTRIBUTOR`, `DOCUMENTER`, `OBSERVER`, `CONSUMER`, `BILLING_MANAGER`",
+				Required:    true,
+				Validators: []validator.String{
+					orgRoleValidator,
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationMemberRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationMemberRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan OrganizationMemberRoleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The user must already be a member, see apollostudio_org_invitation
+	member, err := r.client.GetOrganizationMember(ctx, plan.UserId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get organization member",
+			fmt.Sprintf("Failed to get organization member: %s", err.Error()),
+		)
+		return
+	}
+
+	if member.User.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get organization member",
+			fmt.Sprintf("Failed to get organization member: %s because the user isn't a member of the organization.", plan.UserId.ValueString()),
+		)
+		return
+	}
+
+	// Update the role
+	err = r.client.UpdateOrganizationMemberRole(ctx, plan.UserId.ValueString(), client.UserPermission(plan.Role.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update organization member role",
+			fmt.Sprintf("Failed to update organization member role: %s", err.Error()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OrganizationMemberRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Return values from state
+	var state OrganizationMemberRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the member
+	member, err := r.client.GetOrganizationMember(ctx, state.UserId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get organization member",
+			fmt.Sprintf("Failed to get organization member: %s", err.Error()),
+		)
+		return
+	}
+
+	if member.User.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get organization member",
+			fmt.Sprintf("Failed to get organization member: %s because the user isn't a member of the organization anymore. Might have been removed", state.UserId.ValueString()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate response
+	state.Role = types.StringValue(string(member.Permission))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *OrganizationMemberRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Return values from plan
+	var plan OrganizationMemberRoleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the role
+	err := r.client.UpdateOrganizationMemberRole(ctx, plan.UserId.ValueString(), client.UserPermission(plan.Role.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update organization member role",
+			fmt.Sprintf("Failed to update organization member role: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OrganizationMemberRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Return values from state
+	var state OrganizationMemberRoleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the member
+	err := r.client.RemoveOrganizationMember(ctx, state.UserId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to remove organization member",
+			fmt.Sprintf("Failed to remove organization member: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (r *OrganizationMemberRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("user_id"), req, resp)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrgMemberRoleResource(t *testing.T) {
+	// Destroying the resource removes the member, so it needs a disposable member of the organization
+	userId := os.Getenv("APOLLO_TEST_MEMBER_ID")
+	if userId == "" {
+		t.Skip("APOLLO_TEST_MEMBER_ID must be set to the ID of a disposable organization member")
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_org_member_role" "this" {
+					user_id = "` + userId + `"
+					role    = "OBSERVER"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_org_member_role.this", "user_id", userId),
+					resource.TestCheckResourceAttr("apollostudio_org_member_role.this", "role", "OBSERVER"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:                         "apollostudio_org_member_role.this",
+				ImportState:                          true,
+				ImportStateId:                        userId,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "user_id",
+			},
+			// Update and read testing
+			{
+				Config: providerConfig + `resource "apollostudio_org_member_role" "this" {
+					user_id = "` + userId + `"
+					role    = "CONSUMER"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_org_member_role.this", "role", "CONSUMER"),
+				),
+			},
+		},
+	})
+}
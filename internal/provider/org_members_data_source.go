@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var _ datasource.DataSource = &OrganizationMembersDataSource{}
+
+type OrganizationMembersDataSource struct {
+	client *client.ApolloClient
+}
+
+type OrganizationMemberModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Email        types.String `tfsdk:"email"`
+	Role         types.String `tfsdk:"role"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	LastActiveAt types.String `tfsdk:"last_active_at"`
+}
+
+type OrganizationMembersDataSourceModel struct {
+	Members []OrganizationMemberModel `tfsdk:"members"`
+}
+
+func NewOrganizationMembersDataSource() datasource.DataSource {
+	return &OrganizationMembersDataSource{}
+}
+
+func (d *OrganizationMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_members"
+}
+
+func (d *OrganizationMembersDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides the members of the current organization",
+		Attributes: map[string]schema.Attribute{
+			"members": schema.ListNestedAttribute{
+				Description: "List of members",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the user",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the user",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "Email of the user",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role of the user in the organization",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Date the user joined the organization",
+							Computed:    true,
+						},
+						"last_active_at": schema.StringAttribute{
+							Description: "Date the user last authenticated, empty when the user never did",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationMembersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := d.client.GetOrganizationMembers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get organization members",
+			fmt.Sprintf("Failed to get organization members: %s", err.Error()),
+		)
+		return
+	}
+
+	data.Members = make([]OrganizationMemberModel, 0, len(members))
+	for _, member := range members {
+		data.Members = append(data.Members, OrganizationMemberModel{
+			Id:           types.StringValue(member.User.Id),
+			Name:         types.StringValue(member.User.Name),
+			Email:        types.StringValue(member.User.Email),
+			Role:         types.StringValue(string(member.Permission)),
+			CreatedAt:    types.StringValue(member.CreatedAt),
+			LastActiveAt: types.StringValue(member.User.LastAuthenticatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrgMembersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "apollostudio_org_members" "current" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.apollostudio_org_members.current", "members.#"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_org_members.current", "members.0.id"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_org_members.current", "members.0.role"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,431 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource                   = &PerformanceAlertResource{}
+	_ resource.ResourceWithConfigure      = &PerformanceAlertResource{}
+	_ resource.ResourceWithImportState    = &PerformanceAlertResource{}
+	_ resource.ResourceWithValidateConfig = &PerformanceAlertResource{}
+)
+
+type PerformanceAlertResource struct {
+	client *client.ApolloClient
+}
+
+type PerformanceAlertResourceModel struct {
+	GraphId                types.String  `tfsdk:"graph_id"`
+	Id                     types.String  `tfsdk:"id"`
+	VariantName            types.String  `tfsdk:"variant_name"`
+	Metric                 types.String  `tfsdk:"metric"`
+	Threshold              types.Float64 `tfsdk:"threshold"`
+	ThresholdType          types.String  `tfsdk:"threshold_type"`
+	Window                 types.String  `tfsdk:"window"`
+	Scope                  types.String  `tfsdk:"scope"`
+	OperationNames         types.Set     `tfsdk:"operation_names"`
+	ExcludedOperationNames types.Set     `tfsdk:"excluded_operation_names"`
+	ChannelIds             types.Set     `tfsdk:"channel_ids"`
+	Enabled                types.Bool    `tfsdk:"enabled"`
+}
+
+func NewPerformanceAlertResource() resource.Resource {
+	return &PerformanceAlertResource{}
+}
+
+func (r *PerformanceAlertResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_performance_alert"
+}
+
+func (r *PerformanceAlertResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a performance alert of a graph, notifying channels when the request rate, error rate or latency of operations crosses a threshold",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the alert",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"variant_name": schema.StringAttribute{
+				Description: "Name of the variant whose traffic is watched. Every variant is watched when omitted",
+				Optional:    true,
+			},
+			"metric": schema.StringAttribute{
+				Description: "Metric watched by the alert. This can be one of: `REQUESTS_PER_MINUTE`, `ERROR_COUNT_PER_MINUTE`, `ERROR_PERCENTAGE`, `P50_DURATION`, `P95_DURATION`, `P99_DURATION`",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.QueryTriggerMetricRequestsPerMinute),
+						string(client.QueryTriggerMetricErrorCountPerMinute),
+						string(client.QueryTriggerMetricErrorPercentage),
+						string(client.QueryTriggerMetricP50Duration),
+						string(client.QueryTriggerMetricP95Duration),
+						string(client.QueryTriggerMetricP99Duration),
+					),
+				},
+			},
+			"threshold": schema.Float64Attribute{
+				Description: "Value of the metric triggering the alert. Durations are in milliseconds and percentages between `0` and `100`",
+				Required:    true,
+			},
+			"threshold_type": schema.StringAttribute{
+				Description: "Whether the alert triggers above or below the threshold. This can be one of: `ABOVE_OR_EQUAL`, `BELOW_OR_EQUAL`. Defaults to `ABOVE_OR_EQUAL`",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(client.QueryTriggerThresholdTypeAboveOrEqual)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.QueryTriggerThresholdTypeAboveOrEqual),
+						string(client.QueryTriggerThresholdTypeBelowOrEqual),
+					),
+				},
+			},
+			"window": schema.StringAttribute{
+				Description: "Time window the metric is computed over. This can be one of: `ONE_MINUTE`, `FIVE_MINUTES`, `FIFTEEN_MINUTES`",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.QueryTriggerWindowOneMinute),
+						string(client.QueryTriggerWindowFiveMinutes),
+						string(client.QueryTriggerWindowFifteenMinutes),
+					),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Description: "Operations the metric is computed for. This can be one of: `ALL` (the whole traffic), `ANY` (any single operation), `INCLUDED` (the operations of `operation_names`), `EXCLUDED` (every operation but the ones of `excluded_operation_names`). Defaults to `ALL`",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(client.QueryTriggerScopeAll)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.QueryTriggerScopeAll),
+						string(client.QueryTriggerScopeAny),
+						string(client.QueryTriggerScopeIncluded),
+						string(client.QueryTriggerScopeExcluded),
+					),
+				},
+			},
+			"operation_names": schema.SetAttribute{
+				Description: "Names of the operations watched when `scope` is `INCLUDED`",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"excluded_operation_names": schema.SetAttribute{
+				Description: "Names of the operations ignored when `scope` is `EXCLUDED`",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"channel_ids": schema.SetAttribute{
+				Description: "IDs of the notification channels the alert is sent to",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the alert is sent. Defaults to `true`",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *PerformanceAlertResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PerformanceAlertResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config PerformanceAlertResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Scope.IsUnknown() {
+		return
+	}
+
+	// Operation names required by each scope, the other scopes don't allow any
+	scope := client.QueryTriggerScopeAll
+	if !config.Scope.IsNull() {
+		scope = client.QueryTriggerScope(config.Scope.ValueString())
+	}
+	attributes := map[string]types.Set{
+		"operation_names":          config.OperationNames,
+		"excluded_operation_names": config.ExcludedOperationNames,
+	}
+	required := map[client.QueryTriggerScope]string{
+		client.QueryTriggerScopeIncluded: "operation_names",
+		client.QueryTriggerScopeExcluded: "excluded_operation_names",
+	}
+
+	for name, value := range attributes {
+		if name == required[scope] && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing performance alert attribute",
+				fmt.Sprintf("%s is required for a performance alert with the %s scope", name, scope),
+			)
+		}
+		if name != required[scope] && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unexpected performance alert attribute",
+				fmt.Sprintf("%s can't be set for a performance alert with the %s scope", name, scope),
+			)
+		}
+	}
+}
+
+// newQueryTriggerInput builds the alert sent to the API from the model.
+func newQueryTriggerInput(ctx context.Context, model PerformanceAlertResourceModel) (client.QueryTriggerInput, error) {
+	input := client.QueryTriggerInput{
+		Metric:                 client.QueryTriggerMetric(model.Metric.ValueString()),
+		Threshold:              model.Threshold.ValueFloat64(),
+		ThresholdType:          client.QueryTriggerThresholdType(model.ThresholdType.ValueString()),
+		Window:                 client.QueryTriggerWindow(model.Window.ValueString()),
+		Scope:                  client.QueryTriggerScope(model.Scope.ValueString()),
+		OperationNames:         make([]string, 0),
+		ExcludedOperationNames: make([]string, 0),
+		Enabled:                model.Enabled.ValueBool(),
+	}
+	if !model.VariantName.IsNull() {
+		variantName := model.VariantName.ValueString()
+		input.Variant = &variantName
+	}
+	if diags := model.OperationNames.ElementsAs(ctx, &input.OperationNames, false); diags.HasError() {
+		return client.QueryTriggerInput{}, fmt.Errorf("could not read operation names")
+	}
+	if diags := model.ExcludedOperationNames.ElementsAs(ctx, &input.ExcludedOperationNames, false); diags.HasError() {
+		return client.QueryTriggerInput{}, fmt.Errorf("could not read excluded operation names")
+	}
+	if diags := model.ChannelIds.ElementsAs(ctx, &input.ChannelIds, false); diags.HasError() {
+		return client.QueryTriggerInput{}, fmt.Errorf("could not read channel IDs")
+	}
+	return input, nil
+}
+
+func (r *PerformanceAlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan PerformanceAlertResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, err := newQueryTriggerInput(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create performance alert",
+			fmt.Sprintf("Failed to create performance alert: %s", err.Error()),
+		)
+		return
+	}
+
+	// Create the alert
+	alert, err := r.client.UpsertPerformanceAlert(ctx, plan.GraphId.ValueString(), "", input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create performance alert",
+			fmt.Sprintf("Failed to create performance alert: %s", err.Error()),
+		)
+		return
+	}
+
+	// GraphQL API does not return an error when the alert creation fails
+	if alert.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to create performance alert",
+			fmt.Sprintf("Failed to create performance alert on graph %s, unexpected error. Might be the API key used to configure the provider does not have the right permissions on the graph.", plan.GraphId.ValueString()),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(alert.Id)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PerformanceAlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Return values from state
+	var state PerformanceAlertResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the alert
+	alert, err := r.client.GetPerformanceAlert(ctx, state.GraphId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get performance alert",
+			fmt.Sprintf("Failed to get performance alert: %s", err.Error()),
+		)
+		return
+	}
+
+	if alert.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get performance alert",
+			fmt.Sprintf("Failed to get performance alert: %s because the alert wasn't found. Might have been deleted", state.Id.ValueString()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate response
+	state.VariantName = types.StringNull()
+	if alert.Variant != "" {
+		state.VariantName = types.StringValue(alert.Variant)
+	}
+	state.Metric = types.StringValue(string(alert.Metric))
+	state.Threshold = types.Float64Value(alert.Threshold)
+	state.ThresholdType = types.StringValue(string(alert.ThresholdType))
+	state.Window = types.StringValue(string(alert.Window))
+	state.Scope = types.StringValue(string(alert.Scope))
+	state.OperationNames = stringSetModel(alert.OperationNames)
+	state.ExcludedOperationNames = stringSetModel(alert.ExcludedOperationNames)
+	channelIds := make([]string, 0, len(alert.Channels))
+	for _, channel := range alert.Channels {
+		channelIds = append(channelIds, channel.Id)
+	}
+	state.ChannelIds = stringSetModel(channelIds)
+	state.Enabled = types.BoolValue(alert.Enabled)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PerformanceAlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Return values from plan
+	var plan PerformanceAlertResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Return values from state
+	var state PerformanceAlertResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, err := newQueryTriggerInput(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update performance alert",
+			fmt.Sprintf("Failed to update performance alert: %s", err.Error()),
+		)
+		return
+	}
+
+	// Update the alert
+	_, err = r.client.UpsertPerformanceAlert(ctx, state.GraphId.ValueString(), state.Id.ValueString(), input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update performance alert",
+			fmt.Sprintf("Failed to update performance alert: %s", err.Error()),
+		)
+		return
+	}
+
+	// Saved updated values to state
+	plan.Id = state.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PerformanceAlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Return values from state
+	var state PerformanceAlertResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the alert
+	err := r.client.DeletePerformanceAlert(ctx, state.GraphId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete performance alert",
+			fmt.Sprintf("Failed to delete performance alert: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (r *PerformanceAlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, alertId, found := strings.Cut(req.ID, ":")
+	if !found || graphId == "" || alertId == "" {
+		resp.Diagnostics.AddError(
+			"Invalid performance alert ID",
+			fmt.Sprintf("Invalid performance alert ID: %s, expected <graph-id>:<alert-id>", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), alertId)...)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPerformanceAlertResource(t *testing.T) {
+	channel := `resource "apollostudio_notification_channel" "this" {
+		graph_id = "testacc-terraform"
+		type     = "WEBHOOK"
+		name     = "testacc-alert"
+		url      = "https://example.com/testacc"
+	}
+	`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + channel + `resource "apollostudio_performance_alert" "this" {
+					graph_id    = "testacc-terraform"
+					metric      = "ERROR_PERCENTAGE"
+					threshold   = 5
+					window      = "FIVE_MINUTES"
+					scope       = "INCLUDED"
+					channel_ids = [apollostudio_notification_channel.this.id]
+				}`,
+				ExpectError: regexp.MustCompile("Missing performance alert attribute"),
+			},
+			{
+				Config: providerConfig + channel + `resource "apollostudio_performance_alert" "this" {
+					graph_id     = "testacc-terraform"
+					variant_name = "current"
+					metric       = "ERROR_PERCENTAGE"
+					threshold    = 5
+					window       = "FIVE_MINUTES"
+					channel_ids  = [apollostudio_notification_channel.this.id]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollostudio_performance_alert.this", "id"),
+					resource.TestCheckResourceAttr("apollostudio_performance_alert.this", "scope", "ALL"),
+					resource.TestCheckResourceAttr("apollostudio_performance_alert.this", "threshold_type", "ABOVE_OR_EQUAL"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: providerConfig + channel + `resource "apollostudio_performance_alert" "this" {
+					graph_id        = "testacc-terraform"
+					variant_name    = "current"
+					metric          = "P95_DURATION"
+					threshold       = 500
+					window          = "FIFTEEN_MINUTES"
+					scope           = "INCLUDED"
+					operation_names = ["TestAcc"]
+					channel_ids     = [apollostudio_notification_channel.this.id]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_performance_alert.this", "metric", "P95_DURATION"),
+					resource.TestCheckResourceAttr("apollostudio_performance_alert.this", "scope", "INCLUDED"),
+					resource.TestCheckResourceAttr("apollostudio_performance_alert.this", "operation_names.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource                = &PersistedQueryListResource{}
+	_ resource.ResourceWithConfigure   = &PersistedQueryListResource{}
+	_ resource.ResourceWithImportState = &PersistedQueryListResource{}
+)
+
+type PersistedQueryListResource struct {
+	client *client.ApolloClient
+}
+
+type PersistedQueryListResourceModel struct {
+	GraphId        types.String `tfsdk:"graph_id"`
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	LinkedVariants types.Set    `tfsdk:"linked_variants"`
+}
+
+func NewPersistedQueryListResource() resource.Resource {
+	return &PersistedQueryListResource{}
+}
+
+func (r *PersistedQueryListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_persisted_query_list"
+}
+
+func (r *PersistedQueryListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a persisted query list of a graph and the variants linked to it",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the persisted query list",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the persisted query list",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the persisted query list",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"linked_variants": schema.SetAttribute{
+				Description: "Names of the variants linked to the persisted query list. A variant can only be linked to one list at a time. Omit the attribute rather than setting an empty set to unlink every variant",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *PersistedQueryListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// linkedVariantsModel maps the linked variants of a list to the model, keeping the attribute null when there are none.
+func linkedVariantsModel(list client.PersistedQueryList) types.Set {
+	if len(list.LinkedVariants) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	linkedVariants := make([]attr.Value, 0, len(list.LinkedVariants))
+	for _, variant := range list.LinkedVariants {
+		linkedVariants = append(linkedVariants, types.StringValue(variant.Name))
+	}
+	return types.SetValueMust(types.StringType, linkedVariants)
+}
+
+// updateLinkedVariants links the variants only present in plan and unlinks the ones only present in state.
+func (r *PersistedQueryListResource) updateLinkedVariants(ctx context.Context, graphId string, listId string, state types.Set, plan types.Set) error {
+	var stateVariants, planVariants []string
+	if diags := state.ElementsAs(ctx, &stateVariants, false); diags.HasError() {
+		return fmt.Errorf("could not read linked variants from state")
+	}
+	if diags := plan.ElementsAs(ctx, &planVariants, false); diags.HasError() {
+		return fmt.Errorf("could not read linked variants from plan")
+	}
+	linked := make(map[string]bool)
+	for _, variantName := range stateVariants {
+		linked[variantName] = true
+	}
+	wanted := make(map[string]bool)
+	for _, variantName := range planVariants {
+		wanted[variantName] = true
+	}
+	for variantName := range linked {
+		if !wanted[variantName] {
+			if err := r.client.UnlinkPersistedQueryList(ctx, graphId, variantName); err != nil {
+				return fmt.Errorf("could not unlink variant %s: %w", variantName, err)
+			}
+		}
+	}
+	for variantName := range wanted {
+		if !linked[variantName] {
+			if err := r.client.LinkPersistedQueryList(ctx, graphId, variantName, listId); err != nil {
+				return fmt.Errorf("could not link variant %s: %w", variantName, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *PersistedQueryListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan PersistedQueryListResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the list
+	list, err := r.client.CreatePersistedQueryList(ctx, plan.GraphId.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create persisted query list",
+			fmt.Sprintf("Failed to create persisted query list: %s", err.Error()),
+		)
+		return
+	}
+
+	// Save the list right away so it's not orphaned if linking fails
+	plan.Id = types.StringValue(list.Id)
+	linkedVariants := plan.LinkedVariants
+	plan.LinkedVariants = types.SetNull(types.StringType)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Link the variants
+	err = r.updateLinkedVariants(ctx, plan.GraphId.ValueString(), list.Id, types.SetNull(types.StringType), linkedVariants)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to link persisted query list",
+			fmt.Sprintf("Failed to link persisted query list %s: %s", list.Id, err.Error()),
+		)
+		return
+	}
+
+	plan.LinkedVariants = linkedVariants
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PersistedQueryListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Return values from state
+	var state PersistedQueryListResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the list
+	list, err := r.client.GetPersistedQueryList(ctx, state.GraphId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get persisted query list",
+			fmt.Sprintf("Failed to get persisted query list: %s", err.Error()),
+		)
+		return
+	}
+
+	if list.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get persisted query list",
+			fmt.Sprintf("Failed to get persisted query list: %s because the list wasn't found. Might have been deleted", state.Id.ValueString()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate response
+	state.Name = types.StringValue(list.Name)
+	state.Description = types.StringValue(list.Description)
+	state.LinkedVariants = linkedVariantsModel(list)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PersistedQueryListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Return values from plan
+	var plan PersistedQueryListResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Return values from state
+	var state PersistedQueryListResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update name and description
+	if plan.Name.ValueString() != state.Name.ValueString() || plan.Description.ValueString() != state.Description.ValueString() {
+		err := r.client.UpdatePersistedQueryList(ctx, state.GraphId.ValueString(), state.Id.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to update persisted query list",
+				fmt.Sprintf("Failed to update persisted query list: %s", err.Error()),
+			)
+			return
+		}
+	}
+
+	// Update linked variants
+	err := r.updateLinkedVariants(ctx, state.GraphId.ValueString(), state.Id.ValueString(), state.LinkedVariants, plan.LinkedVariants)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to link persisted query list",
+			fmt.Sprintf("Failed to link persisted query list %s: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Saved updated values to state
+	plan.Id = state.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PersistedQueryListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Return values from state
+	var state PersistedQueryListResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A linked list can't be deleted
+	err := r.updateLinkedVariants(ctx, state.GraphId.ValueString(), state.Id.ValueString(), state.LinkedVariants, types.SetNull(types.StringType))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to unlink persisted query list",
+			fmt.Sprintf("Failed to unlink persisted query list %s: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Delete the list
+	err = r.client.RemovePersistedQueryList(ctx, state.GraphId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete persisted query list",
+			fmt.Sprintf("Failed to delete persisted query list: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (r *PersistedQueryListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, listId, found := strings.Cut(req.ID, ":")
+	if !found || graphId == "" || listId == "" {
+		resp.Diagnostics.AddError(
+			"Invalid persisted query list ID",
+			fmt.Sprintf("Invalid persisted query list ID: %s, expected <graph-id>:<list-id>", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), listId)...)
+}
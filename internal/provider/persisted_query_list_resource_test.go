@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPersistedQueryListResource(t *testing.T) {
+	randomId := uuid.New().String()[0:8]
+	listName := fmt.Sprintf("test-%s", randomId)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_persisted_query_list" "this" {
+					graph_id = "testacc-terraform"
+					name = "` + listName + `"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollostudio_persisted_query_list.this", "id"),
+					resource.TestCheckResourceAttr("apollostudio_persisted_query_list.this", "name", listName),
+					resource.TestCheckResourceAttr("apollostudio_persisted_query_list.this", "description", ""),
+					resource.TestCheckNoResourceAttr("apollostudio_persisted_query_list.this", "linked_variants"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName: "apollostudio_persisted_query_list.this",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "testacc-terraform:" + s.RootModule().Resources["apollostudio_persisted_query_list.this"].Primary.Attributes["id"], nil
+				},
+				ImportStateVerify: true,
+			},
+			// Update and link a variant
+			{
+				Config: providerConfig + `resource "apollostudio_persisted_query_list" "this" {
+					graph_id = "testacc-terraform"
+					name = "` + listName + `"
+					description = "Test List Updated"
+					linked_variants = ["current"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_persisted_query_list.this", "description", "Test List Updated"),
+					resource.TestCheckResourceAttr("apollostudio_persisted_query_list.this", "linked_variants.#", "1"),
+					resource.TestCheckTypeSetElemAttr("apollostudio_persisted_query_list.this", "linked_variants.*", "current"),
+				),
+			},
+			// Unlink the variant
+			{
+				Config: providerConfig + `resource "apollostudio_persisted_query_list" "this" {
+					graph_id = "testacc-terraform"
+					name = "` + listName + `"
+					description = "Test List Updated"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("apollostudio_persisted_query_list.this", "linked_variants"),
+				),
+			},
+			// Delete is automatically done by the framework
+		},
+	})
+}
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource               = &PersistedQueryManifestResource{}
+	_ resource.ResourceWithConfigure  = &PersistedQueryManifestResource{}
+	_ resource.ResourceWithModifyPlan = &PersistedQueryManifestResource{}
+)
+
+type PersistedQueryManifestResource struct {
+	client *client.ApolloClient
+}
+
+type PersistedQueryManifestResourceModel struct {
+	GraphId    types.String `tfsdk:"graph_id"`
+	ListId     types.String `tfsdk:"list_id"`
+	Manifest   types.String `tfsdk:"manifest"`
+	Operations types.Map    `tfsdk:"operations"`
+	Revision   types.Int64  `tfsdk:"revision"`
+}
+
+func NewPersistedQueryManifestResource() resource.Resource {
+	return &PersistedQueryManifestResource{}
+}
+
+func (r *PersistedQueryManifestResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_persisted_query_manifest"
+}
+
+func (r *PersistedQueryManifestResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Publish a persisted query manifest to a persisted query list. Operations removed from the manifest are removed from the list",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"list_id": schema.StringAttribute{
+				Description: "ID of the persisted query list",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest": schema.StringAttribute{
+				Description: "Content of the manifest, in the JSON format produced by `generate-persisted-query-manifest`",
+				Required:    true,
+			},
+			"operations": schema.MapAttribute{
+				Description: "Operations of the manifest, keyed by operation ID with the operation type and name as value",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"revision": schema.Int64Attribute{
+				Description: "Revision of the persisted query list build created by the last publish",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *PersistedQueryManifestResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// persistedQueryOperationsModel maps the operations of a manifest to the `operations` attribute.
+func persistedQueryOperationsModel(operations []client.PersistedQueryInput) types.Map {
+	elements := make(map[string]attr.Value, len(operations))
+	for _, operation := range operations {
+		elements[operation.Id] = types.StringValue(strings.TrimSpace(operation.Type + " " + operation.Name))
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// ModifyPlan computes the operations from the manifest so the plan shows which operations are added or removed.
+func (r *PersistedQueryManifestResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var manifest types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("manifest"), &manifest)...)
+	if resp.Diagnostics.HasError() || manifest.IsUnknown() || manifest.IsNull() {
+		return
+	}
+
+	operations, err := client.ParsePersistedQueryManifest(manifest.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("manifest"),
+			"Invalid persisted query manifest",
+			fmt.Sprintf("Invalid persisted query manifest: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("operations"), persistedQueryOperationsModel(operations))...)
+}
+
+func (r *PersistedQueryManifestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan PersistedQueryManifestResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operations, err := client.ParsePersistedQueryManifest(plan.Manifest.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid persisted query manifest",
+			fmt.Sprintf("Invalid persisted query manifest: %s", err.Error()),
+		)
+		return
+	}
+
+	// Publish the operations
+	build, err := r.client.PublishPersistedQueries(ctx, plan.GraphId.ValueString(), plan.ListId.ValueString(), operations, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to publish persisted queries",
+			fmt.Sprintf("Failed to publish persisted queries: %s", err.Error()),
+		)
+		return
+	}
+
+	plan.Operations = persistedQueryOperationsModel(operations)
+	plan.Revision = types.Int64Value(int64(build.Revision))
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PersistedQueryManifestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Return values from state
+	var state PersistedQueryManifestResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Operations of a list can't be read back, only make sure the list still exists
+	list, err := r.client.GetPersistedQueryList(ctx, state.GraphId.ValueString(), state.ListId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get persisted query list",
+			fmt.Sprintf("Failed to get persisted query list: %s", err.Error()),
+		)
+		return
+	}
+
+	if list.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get persisted query list",
+			fmt.Sprintf("Failed to get persisted query list: %s because the list wasn't found. Might have been deleted", state.ListId.ValueString()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *PersistedQueryManifestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Return values from plan
+	var plan PersistedQueryManifestResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Return values from state
+	var state PersistedQueryManifestResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operations, err := client.ParsePersistedQueryManifest(plan.Manifest.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid persisted query manifest",
+			fmt.Sprintf("Invalid persisted query manifest: %s", err.Error()),
+		)
+		return
+	}
+
+	// Operations no longer in the manifest are removed from the list
+	plan.Operations = persistedQueryOperationsModel(operations)
+	removedOperationIds := make([]string, 0)
+	for id := range state.Operations.Elements() {
+		if _, ok := plan.Operations.Elements()[id]; !ok {
+			removedOperationIds = append(removedOperationIds, id)
+		}
+	}
+
+	// Publish the operations
+	build, err := r.client.PublishPersistedQueries(ctx, state.GraphId.ValueString(), state.ListId.ValueString(), operations, removedOperationIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to publish persisted queries",
+			fmt.Sprintf("Failed to publish persisted queries: %s", err.Error()),
+		)
+		return
+	}
+
+	plan.Revision = types.Int64Value(int64(build.Revision))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PersistedQueryManifestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Return values from state
+	var state PersistedQueryManifestResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove every operation of the manifest from the list
+	removedOperationIds := make([]string, 0, len(state.Operations.Elements()))
+	for id := range state.Operations.Elements() {
+		removedOperationIds = append(removedOperationIds, id)
+	}
+
+	_, err := r.client.PublishPersistedQueries(ctx, state.GraphId.ValueString(), state.ListId.ValueString(), nil, removedOperationIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to remove persisted queries",
+			fmt.Sprintf("Failed to remove persisted queries: %s", err.Error()),
+		)
+		return
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccPersistedQueryManifestConfig(listName string, operations string) string {
+	return providerConfig + `resource "apollostudio_persisted_query_list" "this" {
+		graph_id = "testacc-terraform"
+		name = "` + listName + `"
+	}
+
+	resource "apollostudio_persisted_query_manifest" "this" {
+		graph_id = apollostudio_persisted_query_list.this.graph_id
+		list_id = apollostudio_persisted_query_list.this.id
+		manifest = jsonencode({
+			format = "apollo-persisted-query-manifest"
+			version = 1
+			operations = [` + operations + `]
+		})
+	}`
+}
+
+func TestAccPersistedQueryManifestResource(t *testing.T) {
+	randomId := uuid.New().String()[0:8]
+	listName := fmt.Sprintf("test-%s", randomId)
+	countries := `{ id = "countries", name = "Countries", type = "query", body = "query Countries { countries { code } }" }`
+	country := `{ id = "country", name = "Country", type = "query", body = "query Country { country(code: \"FR\") { name } }" }`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPersistedQueryManifestConfig(listName, countries+", "+country),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_persisted_query_manifest.this", "operations.%", "2"),
+					resource.TestCheckResourceAttrSet("apollostudio_persisted_query_manifest.this", "operations.countries"),
+					resource.TestCheckResourceAttrSet("apollostudio_persisted_query_manifest.this", "revision"),
+				),
+			},
+			// Remove an operation from the manifest
+			{
+				Config: testAccPersistedQueryManifestConfig(listName, countries),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_persisted_query_manifest.this", "operations.%", "1"),
+					resource.TestCheckNoResourceAttr("apollostudio_persisted_query_manifest.this", "operations.country"),
+				),
+			},
+			// Delete is automatically done by the framework
+		},
+	})
+}
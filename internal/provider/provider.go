@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -14,7 +15,8 @@ import (
 )
 
 var (
-	_ provider.Provider = &ApolloProvider{}
+	_ provider.Provider                       = &ApolloProvider{}
+	_ provider.ProviderWithEphemeralResources = &ApolloProvider{}
 )
 
 type ApolloProvider struct {
@@ -117,6 +119,7 @@ func (p *ApolloProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *ApolloProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -124,6 +127,21 @@ func (p *ApolloProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewGraphApiKeyResource,
 		NewGraphResource,
 		NewSubGraphResource,
+		NewVariantBuildConfigResource,
+		NewGraphSchemaResource,
+		NewPersistedQueryListResource,
+		NewPersistedQueryManifestResource,
+		NewGraphLinterConfigResource,
+		NewGraphCheckConfigResource,
+		NewOperationCheckOverrideResource,
+		NewSchemaProposalResource,
+		NewNotificationChannelResource,
+		NewSchemaChangeSubscriptionResource,
+		NewPerformanceAlertResource,
+		NewOrganizationInvitationResource,
+		NewOrganizationMemberRoleResource,
+		NewOrganizationApiKeyResource,
+		NewUserApiKeyResource,
 	}
 }
 
@@ -137,5 +155,24 @@ func (p *ApolloProvider) DataSources(ctx context.Context) []func() datasource.Da
 		NewGraphVariantsDataSource,
 		NewGraphApiKeysDataSource,
 		NewSubGraphsDataSource,
+		NewSupergraphSchemaDataSource,
+		NewVariantLaunchDataSource,
+		NewVariantLaunchesDataSource,
+		NewSchemaProposalDataSource,
+		NewOrganizationMembersDataSource,
+		NewOrganizationApiKeysDataSource,
+		NewUserApiKeysDataSource,
+		NewSubGraphDataSource,
+		NewSchemaPublicationsDataSource,
+		NewSchemaDiffDataSource,
+		NewFieldUsageDataSource,
+		NewDeprecatedFieldsDataSource,
+		NewGraphClientsDataSource,
+	}
+}
+
+func (p *ApolloProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewGraphApiKeyTokenEphemeralResource,
 	}
 }
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource                = &SchemaChangeSubscriptionResource{}
+	_ resource.ResourceWithConfigure   = &SchemaChangeSubscriptionResource{}
+	_ resource.ResourceWithImportState = &SchemaChangeSubscriptionResource{}
+)
+
+type SchemaChangeSubscriptionResource struct {
+	client *client.ApolloClient
+}
+
+type SchemaChangeSubscriptionResourceModel struct {
+	GraphId     types.String `tfsdk:"graph_id"`
+	VariantName types.String `tfsdk:"variant_name"`
+	Id          types.String `tfsdk:"id"`
+	ChannelIds  types.Set    `tfsdk:"channel_ids"`
+	Events      types.Set    `tfsdk:"events"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+func NewSchemaChangeSubscriptionResource() resource.Resource {
+	return &SchemaChangeSubscriptionResource{}
+}
+
+func (r *SchemaChangeSubscriptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_change_subscription"
+}
+
+func (r *SchemaChangeSubscriptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a subscription sending the schema changes, build errors and launch failures of a graph variant to notification channels",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"variant_name": schema.StringAttribute{
+				Description: "Name of the variant",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the subscription",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_ids": schema.SetAttribute{
+				Description: "IDs of the notification channels the events are sent to",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"events": schema.SetAttribute{
+				Description: "Events sent to the channels. This can be any of: `SCHEMA_PUBLISH`, `BUILD_ERROR`, `LAUNCH_FAILURE`",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(
+							string(client.RegistrySubscriptionEventSchemaPublish),
+							string(client.RegistrySubscriptionEventBuildError),
+							string(client.RegistrySubscriptionEventLaunchFailure),
+						),
+					),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the events are sent. Defaults to `true`",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *SchemaChangeSubscriptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// upsert creates or updates the subscription from the model, depending on whether it already has an ID.
+func (r *SchemaChangeSubscriptionResource) upsert(ctx context.Context, subscriptionId string, model SchemaChangeSubscriptionResourceModel) (client.RegistrySubscription, error) {
+	var channelIds, events []string
+	if diags := model.ChannelIds.ElementsAs(ctx, &channelIds, false); diags.HasError() {
+		return client.RegistrySubscription{}, fmt.Errorf("could not read channel IDs")
+	}
+	if diags := model.Events.ElementsAs(ctx, &events, false); diags.HasError() {
+		return client.RegistrySubscription{}, fmt.Errorf("could not read events")
+	}
+	subscriptionEvents := make([]client.RegistrySubscriptionEvent, 0, len(events))
+	for _, event := range events {
+		subscriptionEvents = append(subscriptionEvents, client.RegistrySubscriptionEvent(event))
+	}
+	return r.client.UpsertSchemaChangeSubscription(ctx, model.GraphId.ValueString(), subscriptionId, model.VariantName.ValueString(), channelIds, subscriptionEvents, model.Enabled.ValueBool())
+}
+
+func (r *SchemaChangeSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan SchemaChangeSubscriptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the subscription
+	subscription, err := r.upsert(ctx, "", plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create schema change subscription",
+			fmt.Sprintf("Failed to create schema change subscription: %s", err.Error()),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(subscription.Id)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SchemaChangeSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Return values from state
+	var state SchemaChangeSubscriptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the subscription
+	subscription, err := r.client.GetSchemaChangeSubscription(ctx, state.GraphId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get schema change subscription",
+			fmt.Sprintf("Failed to get schema change subscription: %s", err.Error()),
+		)
+		return
+	}
+
+	if subscription.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get schema change subscription",
+			fmt.Sprintf("Failed to get schema change subscription: %s because the subscription wasn't found. Might have been deleted", state.Id.ValueString()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate response
+	channelIds := make([]string, 0, len(subscription.Channels))
+	for _, channel := range subscription.Channels {
+		channelIds = append(channelIds, channel.Id)
+	}
+	events := make([]string, 0, len(subscription.Events))
+	for _, event := range subscription.Events {
+		events = append(events, string(event))
+	}
+	state.VariantName = types.StringValue(subscription.Variant)
+	state.ChannelIds = stringSetModel(channelIds)
+	state.Events = stringSetModel(events)
+	state.Enabled = types.BoolValue(subscription.Enabled)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SchemaChangeSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Return values from plan
+	var plan SchemaChangeSubscriptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Return values from state
+	var state SchemaChangeSubscriptionResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the subscription
+	_, err := r.upsert(ctx, state.Id.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update schema change subscription",
+			fmt.Sprintf("Failed to update schema change subscription: %s", err.Error()),
+		)
+		return
+	}
+
+	// Saved updated values to state
+	plan.Id = state.Id
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SchemaChangeSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Return values from state
+	var state SchemaChangeSubscriptionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the subscription
+	err := r.client.DeleteSchemaChangeSubscription(ctx, state.GraphId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to delete schema change subscription",
+			fmt.Sprintf("Failed to delete schema change subscription: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (r *SchemaChangeSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, subscriptionId, found := strings.Cut(req.ID, ":")
+	if !found || graphId == "" || subscriptionId == "" {
+		resp.Diagnostics.AddError(
+			"Invalid schema change subscription ID",
+			fmt.Sprintf("Invalid schema change subscription ID: %s, expected <graph-id>:<subscription-id>", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), subscriptionId)...)
+}
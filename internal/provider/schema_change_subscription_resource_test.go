@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaChangeSubscriptionResource(t *testing.T) {
+	channel := `resource "apollostudio_notification_channel" "this" {
+		graph_id = "testacc-terraform"
+		type     = "WEBHOOK"
+		name     = "testacc-subscription"
+		url      = "https://example.com/testacc"
+	}
+	`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + channel + `resource "apollostudio_schema_change_subscription" "this" {
+					graph_id     = "testacc-terraform"
+					variant_name = "current"
+					channel_ids  = [apollostudio_notification_channel.this.id]
+					events       = ["SCHEMA_PUBLISH"]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollostudio_schema_change_subscription.this", "id"),
+					resource.TestCheckResourceAttr("apollostudio_schema_change_subscription.this", "events.#", "1"),
+					resource.TestCheckResourceAttr("apollostudio_schema_change_subscription.this", "enabled", "true"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: providerConfig + channel + `resource "apollostudio_schema_change_subscription" "this" {
+					graph_id     = "testacc-terraform"
+					variant_name = "current"
+					channel_ids  = [apollostudio_notification_channel.this.id]
+					events       = ["BUILD_ERROR", "LAUNCH_FAILURE"]
+					enabled      = false
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_schema_change_subscription.this", "events.#", "2"),
+					resource.TestCheckResourceAttr("apollostudio_schema_change_subscription.this", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var _ datasource.DataSource = &SchemaDiffDataSource{}
+
+type SchemaDiffDataSource struct {
+	client *client.ApolloClient
+}
+
+type SchemaChangeModel struct {
+	Code        types.String `tfsdk:"code"`
+	Severity    types.String `tfsdk:"severity"`
+	Category    types.String `tfsdk:"category"`
+	Description types.String `tfsdk:"description"`
+}
+
+type ChangeSummaryCountsModel struct {
+	Additions types.Int64 `tfsdk:"additions"`
+	Removals  types.Int64 `tfsdk:"removals"`
+	Edits     types.Int64 `tfsdk:"edits"`
+}
+
+type SchemaDiffDataSourceModel struct {
+	GraphId    types.String             `tfsdk:"graph_id"`
+	BaseHash   types.String             `tfsdk:"base_hash"`
+	TargetHash types.String             `tfsdk:"target_hash"`
+	Changes    []SchemaChangeModel      `tfsdk:"changes"`
+	Summary    ChangeSummaryCountsModel `tfsdk:"summary"`
+}
+
+func NewSchemaDiffDataSource() datasource.DataSource {
+	return &SchemaDiffDataSource{}
+}
+
+func (d *SchemaDiffDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_diff"
+}
+
+func (d *SchemaDiffDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provide the changes between two schemas of a specific graph, identified by their hashes",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+			},
+			"base_hash": schema.StringAttribute{
+				Description: "Hash of the schema the changes are computed from",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"target_hash": schema.StringAttribute{
+				Description: "Hash of the schema the changes lead to",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"changes": schema.ListNestedAttribute{
+				Description: "List of changes",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							Description: "Code of the change (e.g. `FIELD_REMOVED`)",
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							Description: "Severity of the change. This can be one of: `FAILURE`, `NOTICE`",
+							Computed:    true,
+						},
+						"category": schema.StringAttribute{
+							Description: "Category of the change. This can be one of: `ADDITION`, `REMOVAL`, `EDIT`, `DEPRECATION`",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Human-readable description of the change",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"summary": schema.SingleNestedAttribute{
+				Description: "Number of changes of each kind",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"additions": schema.Int64Attribute{
+						Description: "Number of additions",
+						Computed:    true,
+					},
+					"removals": schema.Int64Attribute{
+						Description: "Number of removals",
+						Computed:    true,
+					},
+					"edits": schema.Int64Attribute{
+						Description: "Number of edits",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SchemaDiffDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SchemaDiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SchemaDiffDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diff, err := d.client.GetSchemaDiff(ctx, data.GraphId.ValueString(), data.BaseHash.ValueString(), data.TargetHash.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get schema diff",
+			fmt.Sprintf("Failed to get schema diff: %s", err.Error()),
+		)
+		return
+	}
+
+	data.Changes = make([]SchemaChangeModel, 0, len(diff.Changes))
+	for _, change := range diff.Changes {
+		data.Changes = append(data.Changes, SchemaChangeModel{
+			Code:        types.StringValue(change.Code),
+			Severity:    types.StringValue(string(change.Severity)),
+			Category:    types.StringValue(string(change.Category)),
+			Description: types.StringValue(change.Description),
+		})
+	}
+	data.Summary = ChangeSummaryCountsModel{
+		Additions: types.Int64Value(int64(diff.ChangeSummary.Total.Additions)),
+		Removals:  types.Int64Value(int64(diff.ChangeSummary.Total.Removals)),
+		Edits:     types.Int64Value(int64(diff.ChangeSummary.Total.Edits)),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
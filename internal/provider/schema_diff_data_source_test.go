@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaDiffDataSource(t *testing.T) {
+	publicationsConfig := providerConfig + `
+		data "apollostudio_schema_publications" "this" {
+			graph_id     = "testacc-terraform"
+			variant_name = "current"
+			limit        = 2
+		}
+	`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Changes introduced by the latest publication
+			{
+				Config: publicationsConfig + `
+					data "apollostudio_schema_diff" "this" {
+						graph_id    = "testacc-terraform"
+						base_hash   = data.apollostudio_schema_publications.this.publications[1].hash
+						target_hash = data.apollostudio_schema_publications.this.publications[0].hash
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.apollostudio_schema_diff.this", "changes.#"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_schema_diff.this", "summary.additions"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_schema_diff.this", "summary.removals"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_schema_diff.this", "summary.edits"),
+				),
+			},
+			// A schema has no changes against itself
+			{
+				Config: publicationsConfig + `
+					data "apollostudio_schema_diff" "this" {
+						graph_id    = "testacc-terraform"
+						base_hash   = data.apollostudio_schema_publications.this.publications[0].hash
+						target_hash = data.apollostudio_schema_publications.this.publications[0].hash
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.apollostudio_schema_diff.this", "changes.#", "0"),
+					resource.TestCheckResourceAttr("data.apollostudio_schema_diff.this", "summary.additions", "0"),
+					resource.TestCheckResourceAttr("data.apollostudio_schema_diff.this", "summary.removals", "0"),
+					resource.TestCheckResourceAttr("data.apollostudio_schema_diff.this", "summary.edits", "0"),
+				),
+			},
+		},
+	})
+}
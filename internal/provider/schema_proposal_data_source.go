@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var _ datasource.DataSource = &SchemaProposalDataSource{}
+
+type SchemaProposalDataSource struct {
+	client *client.ApolloClient
+}
+
+type ProposalReviewModel struct {
+	Reviewer  types.String `tfsdk:"reviewer"`
+	Decision  types.String `tfsdk:"decision"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+type SchemaProposalDataSourceModel struct {
+	Id             types.String          `tfsdk:"id"`
+	Title          types.String          `tfsdk:"title"`
+	Description    types.String          `tfsdk:"description"`
+	Status         types.String          `tfsdk:"status"`
+	SourceVariant  types.String          `tfsdk:"source_variant"`
+	BackingVariant types.String          `tfsdk:"backing_variant"`
+	CreatedAt      types.String          `tfsdk:"created_at"`
+	Approved       types.Bool            `tfsdk:"approved"`
+	Reviewers      []types.String        `tfsdk:"reviewers"`
+	Reviews        []ProposalReviewModel `tfsdk:"reviews"`
+}
+
+func NewSchemaProposalDataSource() datasource.DataSource {
+	return &SchemaProposalDataSource{}
+}
+
+func (d *SchemaProposalDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_proposal"
+}
+
+func (d *SchemaProposalDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provide the status and the reviews of a schema proposal",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the proposal",
+				Required:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "Title of the proposal",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the proposal",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the proposal. This can be one of: `DRAFT`, `OPEN`, `APPROVED`, `IMPLEMENTED`, `CLOSED`",
+				Computed:    true,
+			},
+			"source_variant": schema.StringAttribute{
+				Description: "Name of the variant the proposal targets",
+				Computed:    true,
+			},
+			"backing_variant": schema.StringAttribute{
+				Description: "Name of the variant holding the proposed schemas",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation date of the proposal",
+				Computed:    true,
+			},
+			"approved": schema.BoolAttribute{
+				Description: "Whether the proposal was approved, i.e. its status is either `APPROVED` or `IMPLEMENTED`",
+				Computed:    true,
+			},
+			"reviewers": schema.ListAttribute{
+				Description: "Names of the users whose review was requested",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"reviews": schema.ListNestedAttribute{
+				Description: "Reviews of the proposal",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"reviewer": schema.StringAttribute{
+							Description: "Name of the reviewer",
+							Computed:    true,
+						},
+						"decision": schema.StringAttribute{
+							Description: "Decision of the reviewer. This can be one of: `APPROVED`, `CHANGES_REQUESTED`, `NOT_APPLICABLE`",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Creation date of the review",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SchemaProposalDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SchemaProposalDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SchemaProposalDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	proposal, err := d.client.GetProposal(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get schema proposal",
+			fmt.Sprintf("Failed to get schema proposal: %s", err.Error()),
+		)
+		return
+	}
+
+	if proposal.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get schema proposal",
+			fmt.Sprintf("Failed to get schema proposal: %s because the proposal wasn't found.", data.Id.ValueString()),
+		)
+		return
+	}
+
+	data.Title = types.StringValue(proposal.DisplayName)
+	data.Description = types.StringValue(proposal.Description)
+	data.Status = types.StringValue(string(proposal.Status))
+	data.SourceVariant = types.StringValue(proposal.SourceVariant.Name)
+	data.BackingVariant = types.StringValue(proposal.BackingVariant.Name)
+	data.CreatedAt = types.StringValue(proposal.CreatedAt)
+	data.Approved = types.BoolValue(proposal.Status == client.ProposalStatusApproved || proposal.Status == client.ProposalStatusImplemented)
+	data.Reviewers = make([]types.String, 0, len(proposal.ReviewRequests))
+	for _, reviewRequest := range proposal.ReviewRequests {
+		data.Reviewers = append(data.Reviewers, types.StringValue(reviewRequest.Reviewer.Name))
+	}
+	data.Reviews = make([]ProposalReviewModel, 0, len(proposal.Reviews))
+	for _, review := range proposal.Reviews {
+		data.Reviews = append(data.Reviews, ProposalReviewModel{
+			Reviewer:  types.StringValue(review.CreatedBy.Name),
+			Decision:  types.StringValue(string(review.Decision)),
+			CreatedAt: types.StringValue(review.CreatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
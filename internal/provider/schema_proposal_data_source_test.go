@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaProposalDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_schema_proposal" "this" {
+					graph_id       = "testacc-terraform"
+					source_variant = "current"
+					title          = "Test Proposal Data Source"
+					description    = "Test Proposal Description"
+				}
+
+				data "apollostudio_schema_proposal" "this" {
+					id = apollostudio_schema_proposal.this.id
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.apollostudio_schema_proposal.this", "id", "apollostudio_schema_proposal.this", "id"),
+					resource.TestCheckResourceAttrPair("data.apollostudio_schema_proposal.this", "status", "apollostudio_schema_proposal.this", "status"),
+					resource.TestCheckResourceAttrPair("data.apollostudio_schema_proposal.this", "backing_variant", "apollostudio_schema_proposal.this", "backing_variant"),
+					resource.TestCheckResourceAttr("data.apollostudio_schema_proposal.this", "title", "Test Proposal Data Source"),
+					resource.TestCheckResourceAttr("data.apollostudio_schema_proposal.this", "description", "Test Proposal Description"),
+					resource.TestCheckResourceAttr("data.apollostudio_schema_proposal.this", "source_variant", "current"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_schema_proposal.this", "created_at"),
+					resource.TestCheckResourceAttr("data.apollostudio_schema_proposal.this", "approved", "false"),
+					resource.TestCheckResourceAttr("data.apollostudio_schema_proposal.this", "reviews.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,354 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource                = &SchemaProposalResource{}
+	_ resource.ResourceWithConfigure   = &SchemaProposalResource{}
+	_ resource.ResourceWithImportState = &SchemaProposalResource{}
+)
+
+type SchemaProposalResource struct {
+	client *client.ApolloClient
+}
+
+type SchemaProposalResourceModel struct {
+	GraphId        types.String `tfsdk:"graph_id"`
+	Id             types.String `tfsdk:"id"`
+	SourceVariant  types.String `tfsdk:"source_variant"`
+	Title          types.String `tfsdk:"title"`
+	Description    types.String `tfsdk:"description"`
+	Subgraphs      types.Map    `tfsdk:"subgraphs"`
+	BackingVariant types.String `tfsdk:"backing_variant"`
+	Status         types.String `tfsdk:"status"`
+}
+
+func NewSchemaProposalResource() resource.Resource {
+	return &SchemaProposalResource{}
+}
+
+func (r *SchemaProposalResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_proposal"
+}
+
+func (r *SchemaProposalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a schema proposal of a graph variant. Destroying this resource closes the proposal",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the proposal",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_variant": schema.StringAttribute{
+				Description: "Name of the variant the proposal targets",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Title of the proposal",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the proposal",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"subgraphs": schema.MapAttribute{
+				Description: "Proposed schemas of the subgraphs, keyed by subgraph name. A new revision of the proposal is published when they change",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"backing_variant": schema.StringAttribute{
+				Description: "Name of the variant holding the proposed schemas",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the proposal. This can be one of: `DRAFT`, `OPEN`, `APPROVED`, `IMPLEMENTED`, `CLOSED`",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *SchemaProposalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// proposalSubgraphsModel maps the subgraph schemas of a proposal to the model, only keeping the subgraphs present in managed
+// since the backing variant also contains the unchanged subgraphs of the source variant.
+func proposalSubgraphsModel(proposal client.Proposal, managed types.Map) types.Map {
+	if managed.IsNull() {
+		return managed
+	}
+	subgraphs := make(map[string]attr.Value)
+	for _, subgraph := range proposal.BackingVariant.SubGraphs {
+		if _, ok := managed.Elements()[subgraph.Name]; ok {
+			subgraphs[subgraph.Name] = types.StringValue(subgraph.ActivePartialSchema.Sdl)
+		}
+	}
+	return types.MapValueMust(types.StringType, subgraphs)
+}
+
+// publishSubgraphs publishes a revision of the proposal when the subgraph schemas changed.
+func (r *SchemaProposalResource) publishSubgraphs(ctx context.Context, proposalId string, state types.Map, plan types.Map) error {
+	if plan.IsNull() || plan.Equal(state) {
+		return nil
+	}
+	var subgraphs map[string]string
+	if diags := plan.ElementsAs(ctx, &subgraphs, false); diags.HasError() {
+		return fmt.Errorf("could not read subgraphs from plan")
+	}
+	return r.client.PublishProposalSubgraphs(ctx, proposalId, "Published by Terraform", subgraphs)
+}
+
+func (r *SchemaProposalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan SchemaProposalResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the proposal
+	proposalId, err := r.client.CreateProposal(ctx, plan.GraphId.ValueString(), plan.SourceVariant.ValueString(), plan.Title.ValueString(), plan.Description.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to create schema proposal",
+			fmt.Sprintf("Failed to create schema proposal: %s", err.Error()),
+		)
+		return
+	}
+
+	// Save the proposal first, a failed publish then taints it instead of leaving it open and untracked
+	plan.Id = types.StringValue(proposalId)
+	plan.BackingVariant = types.StringNull()
+	plan.Status = types.StringNull()
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Publish the proposed schemas
+	err = r.publishSubgraphs(ctx, proposalId, types.MapNull(types.StringType), plan.Subgraphs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to publish schema proposal subgraphs",
+			fmt.Sprintf("Failed to publish schema proposal subgraphs of %s: %s", proposalId, err.Error()),
+		)
+		return
+	}
+
+	proposal, err := r.client.GetProposal(ctx, proposalId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get schema proposal",
+			fmt.Sprintf("Failed to get schema proposal: %s", err.Error()),
+		)
+		return
+	}
+
+	plan.BackingVariant = types.StringValue(proposal.BackingVariant.Name)
+	plan.Status = types.StringValue(string(proposal.Status))
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SchemaProposalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Return values from state
+	var state SchemaProposalResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the proposal
+	proposal, err := r.client.GetProposal(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get schema proposal",
+			fmt.Sprintf("Failed to get schema proposal: %s", err.Error()),
+		)
+		return
+	}
+
+	if proposal.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get schema proposal",
+			fmt.Sprintf("Failed to get schema proposal: %s because the proposal wasn't found. Might have been deleted", state.Id.ValueString()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate response
+	state.SourceVariant = types.StringValue(proposal.SourceVariant.Name)
+	state.Title = types.StringValue(proposal.DisplayName)
+	state.Description = types.StringValue(proposal.Description)
+	state.Subgraphs = proposalSubgraphsModel(proposal, state.Subgraphs)
+	state.BackingVariant = types.StringValue(proposal.BackingVariant.Name)
+	state.Status = types.StringValue(string(proposal.Status))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SchemaProposalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Return values from plan
+	var plan SchemaProposalResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Return values from state
+	var state SchemaProposalResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update title and description
+	if plan.Title.ValueString() != state.Title.ValueString() || plan.Description.ValueString() != state.Description.ValueString() {
+		err := r.client.UpdateProposal(ctx, state.Id.ValueString(), plan.Title.ValueString(), plan.Description.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to update schema proposal",
+				fmt.Sprintf("Failed to update schema proposal: %s", err.Error()),
+			)
+			return
+		}
+	}
+
+	// Publish the proposed schemas
+	err := r.publishSubgraphs(ctx, state.Id.ValueString(), state.Subgraphs, plan.Subgraphs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to publish schema proposal subgraphs",
+			fmt.Sprintf("Failed to publish schema proposal subgraphs of %s: %s", state.Id.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	proposal, err := r.client.GetProposal(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get schema proposal",
+			fmt.Sprintf("Failed to get schema proposal: %s", err.Error()),
+		)
+		return
+	}
+
+	// Saved updated values to state
+	plan.Id = state.Id
+	plan.BackingVariant = types.StringValue(proposal.BackingVariant.Name)
+	plan.Status = types.StringValue(string(proposal.Status))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SchemaProposalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Return values from state
+	var state SchemaProposalResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Proposals can't be deleted, only closed
+	if state.Status.ValueString() == string(client.ProposalStatusClosed) || state.Status.ValueString() == string(client.ProposalStatusImplemented) {
+		return
+	}
+	err := r.client.UpdateProposalStatus(ctx, state.Id.ValueString(), client.ProposalStatusClosed)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to close schema proposal",
+			fmt.Sprintf("Failed to close schema proposal: %s", err.Error()),
+		)
+		return
+	}
+}
+
+func (r *SchemaProposalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	graphId, proposalId, found := strings.Cut(req.ID, ":")
+	if !found || graphId == "" || proposalId == "" {
+		resp.Diagnostics.AddError(
+			"Invalid schema proposal ID",
+			fmt.Sprintf("Invalid schema proposal ID: %s, expected <graph-id>:<proposal-id>", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), graphId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), proposalId)...)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaProposalResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_schema_proposal" "this" {
+					graph_id       = "testacc-terraform"
+					source_variant = "current"
+					title          = "Test Proposal"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollostudio_schema_proposal.this", "id"),
+					resource.TestCheckResourceAttrSet("apollostudio_schema_proposal.this", "backing_variant"),
+					resource.TestCheckResourceAttr("apollostudio_schema_proposal.this", "title", "Test Proposal"),
+				),
+			},
+			// Update and read testing
+			{
+				Config: providerConfig + `resource "apollostudio_schema_proposal" "this" {
+					graph_id       = "testacc-terraform"
+					source_variant = "current"
+					title          = "Test Proposal Updated"
+					description    = "Test Proposal Description"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_schema_proposal.this", "title", "Test Proposal Updated"),
+					resource.TestCheckResourceAttr("apollostudio_schema_proposal.this", "description", "Test Proposal Description"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var _ datasource.DataSource = &SchemaPublicationsDataSource{}
+
+type SchemaPublicationsDataSource struct {
+	client *client.ApolloClient
+}
+
+type SubgraphChangeModel struct {
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+type SchemaPublicationModel struct {
+	Hash            types.String          `tfsdk:"hash"`
+	CreatedAt       types.String          `tfsdk:"created_at"`
+	Publisher       types.String          `tfsdk:"publisher"`
+	LaunchId        types.String          `tfsdk:"launch_id"`
+	SubgraphChanges []SubgraphChangeModel `tfsdk:"subgraph_changes"`
+}
+
+type SchemaPublicationsDataSourceModel struct {
+	GraphId      types.String             `tfsdk:"graph_id"`
+	VariantName  types.String             `tfsdk:"variant_name"`
+	Limit        types.Int64              `tfsdk:"limit"`
+	Publications []SchemaPublicationModel `tfsdk:"publications"`
+}
+
+func NewSchemaPublicationsDataSource() datasource.DataSource {
+	return &SchemaPublicationsDataSource{}
+}
+
+func (d *SchemaPublicationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_publications"
+}
+
+func (d *SchemaPublicationsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provide the schema publication history of a specific graph variant, newest first",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+			},
+			"variant_name": schema.StringAttribute{
+				Description: "Name of the variant",
+				Required:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of publications to return. Defaults to `10`",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"publications": schema.ListNestedAttribute{
+				Description: "List of publications",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hash": schema.StringAttribute{
+							Description: "Hash of the published schema",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Publication date of the schema",
+							Computed:    true,
+						},
+						"publisher": schema.StringAttribute{
+							Description: "Name of the user or API key which published the schema",
+							Computed:    true,
+						},
+						"launch_id": schema.StringAttribute{
+							Description: "ID of the launch triggered by the publication, null for a non-federated variant",
+							Computed:    true,
+						},
+						"subgraph_changes": schema.ListNestedAttribute{
+							Description: "Subgraphs changed by the publication",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "Name of the subgraph",
+										Computed:    true,
+									},
+									"type": schema.StringAttribute{
+										Description: "Type of change. This can be one of: `ADDITION`, `REMOVAL`, `MODIFICATION`",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SchemaPublicationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SchemaPublicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SchemaPublicationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := 10
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	publications, err := d.client.GetSchemaPublications(ctx, data.GraphId.ValueString(), data.VariantName.ValueString(), limit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get schema publications for the given variant",
+			fmt.Sprintf("Failed to get schema publications for the given variant: %s", err.Error()),
+		)
+		return
+	}
+
+	for _, publication := range publications {
+		launchId := types.StringNull()
+		if publication.Launch.Id != "" {
+			launchId = types.StringValue(publication.Launch.Id)
+		}
+		subgraphChanges := make([]SubgraphChangeModel, 0, len(publication.Launch.SubgraphChanges))
+		for _, subgraphChange := range publication.Launch.SubgraphChanges {
+			subgraphChanges = append(subgraphChanges, SubgraphChangeModel{
+				Name: types.StringValue(subgraphChange.Name),
+				Type: types.StringValue(string(subgraphChange.Type)),
+			})
+		}
+		data.Publications = append(data.Publications, SchemaPublicationModel{
+			Hash:            types.StringValue(publication.Schema.Hash),
+			CreatedAt:       types.StringValue(publication.PublishedAt),
+			Publisher:       types.StringValue(publication.PublishedBy.Name),
+			LaunchId:        launchId,
+			SubgraphChanges: subgraphChanges,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSchemaPublicationsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "apollostudio_schema_publications" "this" {
+						graph_id     = "testacc-terraform"
+						variant_name = "current"
+						limit        = 2
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.apollostudio_schema_publications.this", "publications.#", "2"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_schema_publications.this", "publications.0.hash"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_schema_publications.this", "publications.1.hash"),
+				),
+			},
+		},
+	})
+}
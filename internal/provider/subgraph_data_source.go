@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var _ datasource.DataSource = &SubGraphDataSource{}
+
+// subgraphIdRegex matches the ID of a subgraph, i.e. `<graph-id>@<variant-name>:<subgraph-name>`.
+var subgraphIdRegex = regexp.MustCompile(`^([a-zA-Z0-9_-]+)@([a-zA-Z0-9_-]+):([a-zA-Z0-9_-]+)$`)
+
+type SubGraphDataSource struct {
+	client *client.ApolloClient
+}
+
+type SingleSubGraphDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	GraphId     types.String `tfsdk:"graph_id"`
+	VariantName types.String `tfsdk:"variant_name"`
+	Name        types.String `tfsdk:"name"`
+	Schema      types.String `tfsdk:"schema"`
+	Url         types.String `tfsdk:"url"`
+	Revision    types.String `tfsdk:"revision"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	IsLive      types.Bool   `tfsdk:"is_live"`
+}
+
+func NewSubGraphDataSource() datasource.DataSource {
+	return &SubGraphDataSource{}
+}
+
+func (d *SubGraphDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraph"
+}
+
+func (d *SubGraphDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provide details about a specific subgraph",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the subgraph",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						subgraphIdRegex,
+						"must be in the format of <graph-id>@<variant-name>:<subgraph-name>",
+					),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Computed:    true,
+			},
+			"variant_name": schema.StringAttribute{
+				Description: "Name of the variant",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the subgraph",
+				Computed:    true,
+			},
+			"schema": schema.StringAttribute{
+				Description: "SDL of the active schema of the subgraph",
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "Routing URL of the subgraph",
+				Computed:    true,
+			},
+			"revision": schema.StringAttribute{
+				Description: "Revision of the subgraph",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation date of the active schema",
+				Computed:    true,
+			},
+			"is_live": schema.BoolAttribute{
+				Description: "Boolean indicating if the active schema is live",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *SubGraphDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SubGraphDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SingleSubGraphDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// extract each part of the ID
+	matchs := subgraphIdRegex.FindStringSubmatch(data.Id.ValueString())
+	if matchs == nil {
+		resp.Diagnostics.AddError(
+			"Invalid subgraph ID",
+			fmt.Sprintf("Invalid subgraph ID: %s", data.Id.ValueString()),
+		)
+		return
+	}
+	graphId := matchs[1]
+	variantName := matchs[2]
+	name := matchs[3]
+
+	subgraph, err := d.client.GetSubGraph(ctx, graphId, variantName, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get subgraph",
+			fmt.Sprintf("Failed to get subgraph: %s", err.Error()),
+		)
+		return
+	}
+
+	if subgraph.Name == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get subgraph",
+			fmt.Sprintf("Failed to get subgraph: %s because the subgraph wasn't found.", data.Id.ValueString()),
+		)
+		return
+	}
+
+	data.GraphId = types.StringValue(graphId)
+	data.VariantName = types.StringValue(variantName)
+	data.Name = types.StringValue(subgraph.Name)
+	data.Schema = types.StringValue(subgraph.ActivePartialSchema.Sdl)
+	data.Url = types.StringValue(subgraph.Url)
+	data.Revision = types.StringValue(subgraph.Revision)
+	data.CreatedAt = types.StringValue(subgraph.ActivePartialSchema.CreatedAt)
+	data.IsLive = types.BoolValue(subgraph.ActivePartialSchema.IsLive)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
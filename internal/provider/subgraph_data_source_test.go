@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubgraphDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "apollostudio_subgraph" "this" {
+						id = "testacc-terraform@current:countries"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.apollostudio_subgraph.this", "name", "countries"),
+					resource.TestCheckResourceAttr("data.apollostudio_subgraph.this", "graph_id", "testacc-terraform"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_subgraph.this", "schema"),
+				),
+			},
+		},
+	})
+}
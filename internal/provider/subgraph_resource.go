@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"regexp"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -217,36 +216,8 @@ func (r *SubGraphResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	// Prepare errors and warnings to be shown in output
-	var validationErrorStrBuilder strings.Builder
-	var validationWarningStrBuilder strings.Builder
-
-	for _, result := range validationResults {
-		for _, detail := range result.Details {
-			message := fmt.Sprintf("%s : %s\n", result.TaskName, detail.Message)
-			if detail.Level == client.LogLevelError {
-				validationErrorStrBuilder.WriteString(message)
-			} else {
-				validationWarningStrBuilder.WriteString(message)
-			}
-		}
-	}
-
-	validationErrorStr := strings.TrimSpace(validationErrorStrBuilder.String())
-	validationWarningStr := strings.TrimSpace(validationWarningStrBuilder.String())
-
-	if validationWarningStr != "" {
-		resp.Diagnostics.AddWarning(
-			"Warnings while validating subgraph schema",
-			fmt.Sprintf("Warnings while validating subgraph schema:\n\n%s", validationWarningStr),
-		)
-	}
-
-	if validationErrorStr != "" {
-		resp.Diagnostics.AddError(
-			"Failed to validate subgraph schema",
-			fmt.Sprintf("Failed to validate subgraph schema:\n\n%s", validationErrorStr),
-		)
+	addCheckWorkflowDiagnostics(&resp.Diagnostics, "subgraph schema", validationResults)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
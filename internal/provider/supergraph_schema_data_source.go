@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var _ datasource.DataSource = &SupergraphSchemaDataSource{}
+
+type SupergraphSchemaDataSource struct {
+	client *client.ApolloClient
+}
+
+type SupergraphSchemaDataSourceModel struct {
+	GraphRef       types.String `tfsdk:"graph_ref"`
+	LaunchId       types.String `tfsdk:"launch_id"`
+	SupergraphSdl  types.String `tfsdk:"supergraph_sdl"`
+	SupergraphHash types.String `tfsdk:"supergraph_hash"`
+	ApiSchemaSdl   types.String `tfsdk:"api_schema_sdl"`
+	ApiSchemaHash  types.String `tfsdk:"api_schema_hash"`
+}
+
+func NewSupergraphSchemaDataSource() datasource.DataSource {
+	return &SupergraphSchemaDataSource{}
+}
+
+func (d *SupergraphSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_supergraph_schema"
+}
+
+func (d *SupergraphSchemaDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provide the composed supergraph schema and API schema of a specific graph variant",
+		Attributes: map[string]schema.Attribute{
+			"graph_ref": schema.StringAttribute{
+				Description: "Reference of the variant, in the format of `<graph-id>@<variant-name>`",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^([a-zA-Z0-9_-]+)@([a-zA-Z0-9_-]+)$`),
+						"must be in the format of <graph-id>@<variant-name>",
+					),
+				},
+			},
+			"launch_id": schema.StringAttribute{
+				Description: "ID of the launch that built the supergraph schema",
+				Computed:    true,
+			},
+			"supergraph_sdl": schema.StringAttribute{
+				Description: "SDL of the supergraph schema, as used by the router",
+				Computed:    true,
+			},
+			"supergraph_hash": schema.StringAttribute{
+				Description: "Hash of the supergraph schema",
+				Computed:    true,
+			},
+			"api_schema_sdl": schema.StringAttribute{
+				Description: "SDL of the API schema built by the same launch, as exposed to clients",
+				Computed:    true,
+			},
+			"api_schema_hash": schema.StringAttribute{
+				Description: "Hash of the API schema",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *SupergraphSchemaDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SupergraphSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SupergraphSchemaDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	supergraphSchema, err := d.client.GetSupergraphSchema(ctx, data.GraphRef.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get supergraph schema",
+			fmt.Sprintf("Failed to get supergraph schema: %s", err.Error()),
+		)
+		return
+	}
+
+	if supergraphSchema.VariantId == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get supergraph schema",
+			fmt.Sprintf("Failed to get supergraph schema: %s because the variant wasn't found.", data.GraphRef.ValueString()),
+		)
+		return
+	}
+
+	if supergraphSchema.LaunchId == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get supergraph schema",
+			fmt.Sprintf("Failed to get supergraph schema: %s because the variant has no approved launch yet.", data.GraphRef.ValueString()),
+		)
+		return
+	}
+
+	data.LaunchId = types.StringValue(supergraphSchema.LaunchId)
+	data.SupergraphSdl = types.StringValue(supergraphSchema.SupergraphSdl)
+	data.SupergraphHash = types.StringValue(supergraphSchema.SupergraphHash)
+	data.ApiSchemaSdl = types.StringValue(supergraphSchema.ApiSchemaSdl)
+	data.ApiSchemaHash = types.StringValue(supergraphSchema.ApiSchemaHash)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSupergraphSchemaDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "apollostudio_supergraph_schema" "this" {
+						graph_ref = "testacc-terraform@current"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.apollostudio_supergraph_schema.this", "launch_id"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_supergraph_schema.this", "supergraph_sdl"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_supergraph_schema.this", "api_schema_sdl"),
+				),
+			},
+		},
+	})
+}
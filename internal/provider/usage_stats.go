@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+// defaultStatsTimeWindow is the period usage statistics are computed over when none is configured.
+const defaultStatsTimeWindow = 7 * 24 * time.Hour
+
+type FieldUsageClientModel struct {
+	Name                      types.String `tfsdk:"name"`
+	Version                   types.String `tfsdk:"version"`
+	ExecutionCount            types.Int64  `tfsdk:"execution_count"`
+	ReferencingOperationCount types.Int64  `tfsdk:"referencing_operation_count"`
+}
+
+// fieldUsageTotals is the usage of a field summed over the clients requesting it.
+type fieldUsageTotals struct {
+	parentType                string
+	fieldName                 string
+	executionCount            int64
+	referencingOperationCount int64
+	clients                   []FieldUsageClientModel
+}
+
+// timeWindowAttribute is the attribute configuring the period usage statistics are computed over.
+var timeWindowAttribute = schema.StringAttribute{
+	Description: "Period the usage is computed over, ending now (e.g. `720h`). Defaults to `168h`",
+	Optional:    true,
+	Validators: []validator.String{
+		stringvalidator.RegexMatches(durationRegex, "must be a duration like 168h or 1h30m"),
+	},
+}
+
+// fieldUsageClientsAttribute is the attribute listing the clients requesting a field.
+var fieldUsageClientsAttribute = schema.ListNestedAttribute{
+	Description: "Clients which requested the field",
+	Computed:    true,
+	NestedObject: schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of the client, empty when the client didn't identify itself",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the client, empty when the client didn't identify itself",
+				Computed:    true,
+			},
+			"execution_count": schema.Int64Attribute{
+				Description: "Estimated number of times the client requested the field",
+				Computed:    true,
+			},
+			"referencing_operation_count": schema.Int64Attribute{
+				Description: "Number of operations of the client referencing the field",
+				Computed:    true,
+			},
+		},
+	},
+}
+
+// statsWindow returns the dates a usage period configured as a duration starts and ends at.
+func statsWindow(timeWindow types.String) (client.Timestamp, client.Timestamp, error) {
+	duration := defaultStatsTimeWindow
+	if !timeWindow.IsNull() {
+		var err error
+		duration, err = time.ParseDuration(timeWindow.ValueString())
+		if err != nil {
+			return "", "", err
+		}
+	}
+	to := time.Now().UTC()
+	from := to.Add(-duration)
+	return client.Timestamp(from.Format(time.RFC3339)), client.Timestamp(to.Format(time.RFC3339)), nil
+}
+
+// aggregateFieldUsage sums the usage of each field over its clients, keyed by the coordinate of the field.
+func aggregateFieldUsage(usages []client.FieldUsage) map[string]*fieldUsageTotals {
+	totals := map[string]*fieldUsageTotals{}
+	for _, usage := range usages {
+		coordinate := usage.GroupBy.ParentType + "." + usage.GroupBy.FieldName
+		total, ok := totals[coordinate]
+		if !ok {
+			total = &fieldUsageTotals{
+				parentType: usage.GroupBy.ParentType,
+				fieldName:  usage.GroupBy.FieldName,
+				clients:    make([]FieldUsageClientModel, 0),
+			}
+			totals[coordinate] = total
+		}
+		total.executionCount += usage.Metrics.EstimatedExecutionCount
+		total.referencingOperationCount += usage.Metrics.ReferencingOperationCount
+		total.clients = append(total.clients, FieldUsageClientModel{
+			Name:                      types.StringValue(usage.GroupBy.ClientName),
+			Version:                   types.StringValue(usage.GroupBy.ClientVersion),
+			ExecutionCount:            types.Int64Value(usage.Metrics.EstimatedExecutionCount),
+			ReferencingOperationCount: types.Int64Value(usage.Metrics.ReferencingOperationCount),
+		})
+	}
+	for _, total := range totals {
+		sort.Slice(total.clients, func(i, j int) bool {
+			if total.clients[i].Name.ValueString() != total.clients[j].Name.ValueString() {
+				return total.clients[i].Name.ValueString() < total.clients[j].Name.ValueString()
+			}
+			return total.clients[i].Version.ValueString() < total.clients[j].Version.ValueString()
+		})
+	}
+	return totals
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &UserApiKeyResource{}
+	_ resource.ResourceWithConfigure   = &UserApiKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &UserApiKeyResource{}
+	_ resource.ResourceWithImportState = &UserApiKeyResource{}
+)
+
+type UserApiKeyResource struct {
+	apiKeyResource
+}
+
+type UserApiKeyResourceModel struct {
+	UserId types.String `tfsdk:"user_id"`
+	ApiKeyResourceModel
+}
+
+func NewUserApiKeyResource() resource.Resource {
+	r := &UserApiKeyResource{}
+	r.apiKeyResource = apiKeyResource{owner: r, kind: "user api key"}
+	return r
+}
+
+func (r *UserApiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_api_key"
+}
+
+func (r *UserApiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a personal API key of the user owning the API key used to configure the provider. The API key has the permissions of the user",
+		Attributes: withApiKeyRotationAttributes(map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Description: "ID of the user owning the API key",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "ID of the API key",
+				Computed:    true,
+			},
+			"key_name": schema.StringAttribute{
+				Description: "Name of the API key",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"token": schema.StringAttribute{
+				Description: "Authentication token of the API key. This value is only fully available when creating the API key, the current value is partially masked. An imported API key only has the masked value",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation date of the API key",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+func (r *UserApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan UserApiKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// User API keys can only be created by the user itself
+	userId, err := r.client.GetCurrentUserId(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user api key",
+			"Could not create user api key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Create the API key
+	apiKey, err := r.client.CreateUserApiKey(ctx, userId, plan.KeyName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user api key",
+			"Could not create user api key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// GraphQL API does not return an error when the API key creation fails
+	if apiKey.Id == "" {
+		resp.Diagnostics.AddError(
+			"Error creating user api key",
+			"Could not create user api key, unexpected error. Might be the API key used to configure the provider does not have the right permissions to create API key for user : "+userId+".",
+		)
+		return
+	}
+
+	// Map response body to schema and populate response
+	plan.UserId = types.StringValue(userId)
+	plan.Id = types.StringValue(apiKey.Id)
+	plan.Token = types.StringValue(apiKey.Token)
+	plan.CreatedAt = types.StringValue(apiKey.CreatedAt)
+
+	// Compute when the API key must be rotated
+	plan.ExpiresAt, err = apiKeyExpiresAt(plan.RotationPeriod)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user api key",
+			"Could not parse rotation period, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *UserApiKeyResource) newApiKeyModel() apiKeyResourceModel {
+	return &UserApiKeyResourceModel{}
+}
+
+// importApiKeyModel imports the API keys of the user owning the API key used to configure the provider.
+func (r *UserApiKeyResource) importApiKeyModel(ctx context.Context, id string) (apiKeyResourceModel, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	userId, err := r.client.GetCurrentUserId(ctx)
+	if err != nil {
+		diags.AddError(
+			"Failed to get user api key",
+			fmt.Sprintf("Failed to get user api key: %s", err.Error()),
+		)
+		return nil, "", diags
+	}
+	return &UserApiKeyResourceModel{UserId: types.StringValue(userId)}, id, diags
+}
+
+func (r *UserApiKeyResource) getApiKeys(ctx context.Context, model apiKeyResourceModel) ([]apiKey, error) {
+	userApiKeys, err := r.client.GetUserApiKeys(ctx, model.(*UserApiKeyResourceModel).UserId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	apiKeys := make([]apiKey, 0, len(userApiKeys))
+	for _, ak := range userApiKeys {
+		apiKeys = append(apiKeys, apiKey{Id: ak.Id, KeyName: ak.KeyName, Token: ak.Token, CreatedAt: ak.CreatedAt})
+	}
+	return apiKeys, nil
+}
+
+func (r *UserApiKeyResource) renameApiKey(ctx context.Context, model apiKeyResourceModel, apiKeyId string, keyName string) error {
+	return r.client.RenameUserApiKey(ctx, model.(*UserApiKeyResourceModel).UserId.ValueString(), apiKeyId, keyName)
+}
+
+func (r *UserApiKeyResource) removeApiKey(ctx context.Context, model apiKeyResourceModel, apiKeyId string) error {
+	return r.client.RemoveUserApiKey(ctx, model.(*UserApiKeyResourceModel).UserId.ValueString(), apiKeyId)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccPreCheckPersonalApiKey skips the test unless the provider authenticates with a personal API key.
+func testAccPreCheckPersonalApiKey(t *testing.T) {
+	if _, err := testClient().GetCurrentUserId(context.Background()); err != nil {
+		t.Skipf("Skipping, user API keys need the provider to use a personal API key: %s", err)
+	}
+}
+
+func TestAccUserApiKeyResource(t *testing.T) {
+	randomId := uuid.New().String()[0:8]
+	keyName := fmt.Sprintf("test-%s", randomId)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckPersonalApiKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_user_api_key" "this" {
+					key_name = "` + keyName + `"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_user_api_key.this", "key_name", keyName),
+					resource.TestCheckResourceAttrSet("apollostudio_user_api_key.this", "user_id"),
+					resource.TestCheckResourceAttrSet("apollostudio_user_api_key.this", "token"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:            "apollostudio_user_api_key.this",
+				ImportState:             true,
+				ImportStateId:           keyName,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Rename and rotation with a grace period
+			{
+				Config: providerConfig + `resource "apollostudio_user_api_key" "this" {
+					key_name        = "` + keyName + `-renamed"
+					rotation_period = "720h"
+					grace_period    = "1s"
+
+					lifecycle {
+						create_before_destroy = true
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_user_api_key.this", "key_name", keyName+"-renamed"),
+					resource.TestCheckResourceAttrSet("apollostudio_user_api_key.this", "expires_at"),
+				),
+			},
+		},
+	})
+}
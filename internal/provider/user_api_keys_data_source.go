@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var _ datasource.DataSource = &UserApiKeysDataSource{}
+
+type UserApiKeysDataSource struct {
+	client *client.ApolloClient
+}
+
+type UserApiKeyDataSourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	KeyName   types.String `tfsdk:"key_name"`
+	Token     types.String `tfsdk:"token"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+type UserApiKeysDataSourceModel struct {
+	ApiKeys []UserApiKeyDataSourceModel `tfsdk:"api_keys"`
+}
+
+func NewUserApiKeysDataSource() datasource.DataSource {
+	return &UserApiKeysDataSource{}
+}
+
+func (d *UserApiKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_api_keys"
+}
+
+func (d *UserApiKeysDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provide details about the personal API keys of the user owning the API key used to configure the provider. Beware that the API key token is partially masked when read, it's only available at creation time.",
+		Attributes: map[string]schema.Attribute{
+			"api_keys": schema.ListNestedAttribute{
+				Description: "List of API keys",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the API key",
+							Computed:    true,
+						},
+						"key_name": schema.StringAttribute{
+							Description: "Name of the API key",
+							Computed:    true,
+						},
+						"token": schema.StringAttribute{
+							Description: "Authentication token of the API key. This value is only fully available when creating the API key, the current value is partially masked",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Creation date of the API key",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UserApiKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserApiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserApiKeysDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userId, err := d.client.GetCurrentUserId(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get user api keys",
+			fmt.Sprintf("Failed to get user api keys: %s", err.Error()),
+		)
+		return
+	}
+
+	apiKeys, err := d.client.GetUserApiKeys(ctx, userId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get user api keys",
+			fmt.Sprintf("Failed to get user api keys: %s", err.Error()),
+		)
+		return
+	}
+
+	for _, apiKey := range apiKeys {
+		data.ApiKeys = append(data.ApiKeys, UserApiKeyDataSourceModel{
+			Id:        types.StringValue(apiKey.Id),
+			KeyName:   types.StringValue(apiKey.KeyName),
+			Token:     types.StringValue(apiKey.Token),
+			CreatedAt: types.StringValue(apiKey.CreatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserApiKeysDataSource(t *testing.T) {
+	randomId := uuid.New().String()[0:8]
+	keyName := fmt.Sprintf("test-%s", randomId)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckPersonalApiKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_user_api_key" "this" {
+					key_name = "` + keyName + `"
+				}
+
+				data "apollostudio_user_api_keys" "this" {
+					depends_on = [apollostudio_user_api_key.this]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.apollostudio_user_api_keys.this", "api_keys.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.apollostudio_user_api_keys.this", "api_keys.*", map[string]string{
+						"key_name": keyName,
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var (
+	_ resource.Resource                = &VariantBuildConfigResource{}
+	_ resource.ResourceWithConfigure   = &VariantBuildConfigResource{}
+	_ resource.ResourceWithImportState = &VariantBuildConfigResource{}
+)
+
+type VariantBuildConfigResource struct {
+	client *client.ApolloClient
+}
+
+type VariantBuildConfigResourceModel struct {
+	GraphId           types.String `tfsdk:"graph_id"`
+	VariantName       types.String `tfsdk:"variant_name"`
+	FederationVersion types.String `tfsdk:"federation_version"`
+}
+
+func NewVariantBuildConfigResource() resource.Resource {
+	return &VariantBuildConfigResource{}
+}
+
+func (r *VariantBuildConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variant_build_config"
+}
+
+func (r *VariantBuildConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the build pipeline configuration of a graph variant. Destroying this resource leaves the variant configuration untouched",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 40),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]+$`),
+						"must starts with a letter and contains only letters, numbers, and dashes",
+					),
+				},
+			},
+			"variant_name": schema.StringAttribute{
+				Description: "Name of the variant",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"federation_version": schema.StringAttribute{
+				Description: "Federation version used to compose the supergraph of the variant, in the format `<major>.<minor>` (e.g. `2.3`)",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[1-9][0-9]*\.[0-9]+$`),
+						"must be in the format of <major>.<minor>",
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *VariantBuildConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VariantBuildConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Return values from plan
+	var plan VariantBuildConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the federation version
+	buildConfig, err := r.client.UpdateGraphVariantFederationVersion(ctx, plan.GraphId.ValueString(), plan.VariantName.ValueString(), plan.FederationVersion.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update variant federation version",
+			fmt.Sprintf("Failed to update variant federation version: %s", err.Error()),
+		)
+		return
+	}
+
+	// GraphQL API returns a null variant when it doesn't exist
+	if buildConfig.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to update variant federation version",
+			fmt.Sprintf("Failed to update variant federation version: %s@%s because the variant wasn't found.", plan.GraphId.ValueString(), plan.VariantName.ValueString()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *VariantBuildConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Return values from state
+	var state VariantBuildConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the build config
+	buildConfig, err := r.client.GetGraphVariantBuildConfig(ctx, state.GraphId.ValueString(), state.VariantName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get variant build config",
+			fmt.Sprintf("Failed to get variant build config: %s", err.Error()),
+		)
+		return
+	}
+
+	if buildConfig.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get variant build config",
+			fmt.Sprintf("Failed to get variant build config: %s@%s because the variant wasn't found.", state.GraphId.ValueString(), state.VariantName.ValueString()),
+		)
+		return
+	}
+
+	// Map response body to schema and populate response
+	state.FederationVersion = types.StringValue(buildConfig.FederationVersion)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *VariantBuildConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Return values from plan
+	var plan VariantBuildConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the federation version
+	buildConfig, err := r.client.UpdateGraphVariantFederationVersion(ctx, plan.GraphId.ValueString(), plan.VariantName.ValueString(), plan.FederationVersion.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to update variant federation version",
+			fmt.Sprintf("Failed to update variant federation version: %s", err.Error()),
+		)
+		return
+	}
+
+	// GraphQL API returns a null variant when it doesn't exist
+	if buildConfig.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to update variant federation version",
+			fmt.Sprintf("Failed to update variant federation version: %s@%s because the variant wasn't found.", plan.GraphId.ValueString(), plan.VariantName.ValueString()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VariantBuildConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Return values from state
+	var state VariantBuildConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The build config can't be unset, the variant keeps its last federation version
+	tflog.Info(ctx, fmt.Sprintf("Removing build config of variant %s@%s from state only", state.GraphId.ValueString(), state.VariantName.ValueString()))
+}
+
+func (r *VariantBuildConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, fmt.Sprintf("Import variant build config: %s", req.ID))
+	pattern := "^([a-zA-Z0-9_-]+)@([a-zA-Z0-9_-]+)$"
+	re := regexp.MustCompile(pattern)
+	matchs := re.FindStringSubmatch(req.ID)
+	if matchs == nil {
+		resp.Diagnostics.AddError(
+			"Invalid variant ID",
+			fmt.Sprintf("Invalid variant ID: %s, expected <graph-id>@<variant-name>", req.ID),
+		)
+		return
+	}
+
+	// Get the build config
+	buildConfig, err := r.client.GetGraphVariantBuildConfig(ctx, matchs[1], matchs[2])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get variant build config",
+			fmt.Sprintf("Failed to get variant build config: %s", err.Error()),
+		)
+		return
+	}
+
+	if buildConfig.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get variant build config",
+			fmt.Sprintf("Failed to get variant build config: %s because the variant wasn't found.", req.ID),
+		)
+		return
+	}
+
+	var state VariantBuildConfigResourceModel
+	state.GraphId = types.StringValue(matchs[1])
+	state.VariantName = types.StringValue(matchs[2])
+	state.FederationVersion = types.StringValue(buildConfig.FederationVersion)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
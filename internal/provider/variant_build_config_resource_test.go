@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVariantBuildConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `resource "apollostudio_variant_build_config" "this" {
+					graph_id           = "testacc-terraform"
+					variant_name       = "current"
+					federation_version = "2.3"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_variant_build_config.this", "federation_version", "2.3"),
+				),
+			},
+			// ImportState
+			{
+				ResourceName:                         "apollostudio_variant_build_config.this",
+				ImportState:                          true,
+				ImportStateId:                        "testacc-terraform@current",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "graph_id",
+			},
+			// Update and read testing
+			{
+				Config: providerConfig + `resource "apollostudio_variant_build_config" "this" {
+					graph_id           = "testacc-terraform"
+					variant_name       = "current"
+					federation_version = "2.4"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollostudio_variant_build_config.this", "federation_version", "2.4"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var _ datasource.DataSource = &VariantLaunchDataSource{}
+
+type VariantLaunchDataSource struct {
+	client *client.ApolloClient
+}
+
+type LaunchSubgraphModel struct {
+	Name types.String `tfsdk:"name"`
+	Url  types.String `tfsdk:"url"`
+	Hash types.String `tfsdk:"hash"`
+}
+
+type BuildErrorModel struct {
+	Code    types.String `tfsdk:"code"`
+	Message types.String `tfsdk:"message"`
+}
+
+type DownstreamLaunchModel struct {
+	Id          types.String `tfsdk:"id"`
+	VariantName types.String `tfsdk:"variant_name"`
+	Status      types.String `tfsdk:"status"`
+}
+
+type LaunchModel struct {
+	Id                 types.String            `tfsdk:"id"`
+	Status             types.String            `tfsdk:"status"`
+	CreatedAt          types.String            `tfsdk:"created_at"`
+	ApprovedAt         types.String            `tfsdk:"approved_at"`
+	CompletedAt        types.String            `tfsdk:"completed_at"`
+	FederationVersion  types.String            `tfsdk:"federation_version"`
+	Subgraphs          []LaunchSubgraphModel   `tfsdk:"subgraphs"`
+	BuildErrors        []BuildErrorModel       `tfsdk:"build_errors"`
+	DownstreamLaunches []DownstreamLaunchModel `tfsdk:"downstream_launches"`
+}
+
+type VariantLaunchDataSourceModel struct {
+	GraphId            types.String            `tfsdk:"graph_id"`
+	VariantName        types.String            `tfsdk:"variant_name"`
+	Id                 types.String            `tfsdk:"id"`
+	Status             types.String            `tfsdk:"status"`
+	CreatedAt          types.String            `tfsdk:"created_at"`
+	ApprovedAt         types.String            `tfsdk:"approved_at"`
+	CompletedAt        types.String            `tfsdk:"completed_at"`
+	FederationVersion  types.String            `tfsdk:"federation_version"`
+	Subgraphs          []LaunchSubgraphModel   `tfsdk:"subgraphs"`
+	BuildErrors        []BuildErrorModel       `tfsdk:"build_errors"`
+	DownstreamLaunches []DownstreamLaunchModel `tfsdk:"downstream_launches"`
+}
+
+func NewVariantLaunchDataSource() datasource.DataSource {
+	return &VariantLaunchDataSource{}
+}
+
+// launchAttributes returns the computed attributes describing a launch, shared by the launch data sources.
+func launchAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"status": schema.StringAttribute{
+			Description: "Status of the launch. This can be one of: `LAUNCH_INITIATED`, `LAUNCH_COMPLETED`, `LAUNCH_FAILED`",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "Creation date of the launch",
+			Computed:    true,
+		},
+		"approved_at": schema.StringAttribute{
+			Description: "Approval date of the launch",
+			Computed:    true,
+		},
+		"completed_at": schema.StringAttribute{
+			Description: "Completion date of the launch, empty while the launch is in progress",
+			Computed:    true,
+		},
+		"federation_version": schema.StringAttribute{
+			Description: "Federation version used to build the launch",
+			Computed:    true,
+		},
+		"subgraphs": schema.ListNestedAttribute{
+			Description: "Subgraphs used as input of the launch build",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Name of the subgraph",
+						Computed:    true,
+					},
+					"url": schema.StringAttribute{
+						Description: "Routing URL of the subgraph",
+						Computed:    true,
+					},
+					"hash": schema.StringAttribute{
+						Description: "Hash of the subgraph schema revision",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"build_errors": schema.ListNestedAttribute{
+			Description: "Errors raised while building the launch, empty when the build succeeded",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"code": schema.StringAttribute{
+						Description: "Code of the error",
+						Computed:    true,
+					},
+					"message": schema.StringAttribute{
+						Description: "Message of the error",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"downstream_launches": schema.ListNestedAttribute{
+			Description: "Launches of the contract variants triggered by the launch",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "ID of the downstream launch",
+						Computed:    true,
+					},
+					"variant_name": schema.StringAttribute{
+						Description: "Name of the downstream variant",
+						Computed:    true,
+					},
+					"status": schema.StringAttribute{
+						Description: "Status of the downstream launch",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// newLaunchModel maps a launch returned by the client to its Terraform model.
+func newLaunchModel(launch client.Launch) LaunchModel {
+	model := LaunchModel{
+		Id:                 types.StringValue(launch.Id),
+		Status:             types.StringValue(string(launch.Status)),
+		CreatedAt:          types.StringValue(launch.CreatedAt),
+		ApprovedAt:         types.StringValue(launch.ApprovedAt),
+		CompletedAt:        types.StringValue(launch.CompletedAt),
+		FederationVersion:  types.StringValue(launch.Build.Input.CompositionBuildInput.Version),
+		Subgraphs:          make([]LaunchSubgraphModel, 0),
+		BuildErrors:        make([]BuildErrorModel, 0),
+		DownstreamLaunches: make([]DownstreamLaunchModel, 0),
+	}
+	for _, subgraph := range launch.Build.Input.CompositionBuildInput.Subgraphs {
+		model.Subgraphs = append(model.Subgraphs, LaunchSubgraphModel{
+			Name: types.StringValue(subgraph.Name),
+			Url:  types.StringValue(subgraph.RoutingURL),
+			Hash: types.StringValue(subgraph.Hash),
+		})
+	}
+	for _, buildError := range launch.Build.Result.BuildFailure.ErrorMessages {
+		model.BuildErrors = append(model.BuildErrors, BuildErrorModel{
+			Code:    types.StringValue(buildError.Code),
+			Message: types.StringValue(buildError.Message),
+		})
+	}
+	for _, downstreamLaunch := range launch.DownstreamLaunches {
+		model.DownstreamLaunches = append(model.DownstreamLaunches, DownstreamLaunchModel{
+			Id:          types.StringValue(downstreamLaunch.Id),
+			VariantName: types.StringValue(downstreamLaunch.GraphVariant),
+			Status:      types.StringValue(string(downstreamLaunch.Status)),
+		})
+	}
+	return model
+}
+
+func (d *VariantLaunchDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variant_launch"
+}
+
+func (d *VariantLaunchDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := launchAttributes()
+	attributes["graph_id"] = schema.StringAttribute{
+		Description: "ID of the graph",
+		Required:    true,
+	}
+	attributes["variant_name"] = schema.StringAttribute{
+		Description: "Name of the variant",
+		Required:    true,
+	}
+	attributes["id"] = schema.StringAttribute{
+		Description: "ID of the launch. Defaults to the latest launch of the variant",
+		Optional:    true,
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Provide details about a specific launch of a graph variant",
+		Attributes:  attributes,
+	}
+}
+
+func (d *VariantLaunchDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VariantLaunchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VariantLaunchDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var launch client.Launch
+	var err error
+	if data.Id.IsNull() {
+		launch, err = d.client.GetLatestLaunch(ctx, data.GraphId.ValueString(), data.VariantName.ValueString())
+	} else {
+		launch, err = d.client.GetLaunch(ctx, data.GraphId.ValueString(), data.VariantName.ValueString(), data.Id.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get variant launch",
+			fmt.Sprintf("Failed to get variant launch: %s", err.Error()),
+		)
+		return
+	}
+
+	if launch.Id == "" {
+		resp.Diagnostics.AddError(
+			"Failed to get variant launch",
+			fmt.Sprintf("Failed to get variant launch of %s@%s because the launch wasn't found.", data.GraphId.ValueString(), data.VariantName.ValueString()),
+		)
+		return
+	}
+
+	model := newLaunchModel(launch)
+	data.Id = model.Id
+	data.Status = model.Status
+	data.CreatedAt = model.CreatedAt
+	data.ApprovedAt = model.ApprovedAt
+	data.CompletedAt = model.CompletedAt
+	data.FederationVersion = model.FederationVersion
+	data.Subgraphs = model.Subgraphs
+	data.BuildErrors = model.BuildErrors
+	data.DownstreamLaunches = model.DownstreamLaunches
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVariantLaunchDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "apollostudio_variant_launch" "this" {
+						graph_id     = "testacc-terraform"
+						variant_name = "current"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.apollostudio_variant_launch.this", "id"),
+					resource.TestCheckResourceAttr("data.apollostudio_variant_launch.this", "status", "LAUNCH_COMPLETED"),
+					resource.TestCheckResourceAttr("data.apollostudio_variant_launch.this", "subgraphs.0.name", "countries"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sapher/terraform-provider-apollostudio/pkg/client"
+)
+
+var _ datasource.DataSource = &VariantLaunchesDataSource{}
+
+type VariantLaunchesDataSource struct {
+	client *client.ApolloClient
+}
+
+type VariantLaunchesDataSourceModel struct {
+	GraphId     types.String  `tfsdk:"graph_id"`
+	VariantName types.String  `tfsdk:"variant_name"`
+	Limit       types.Int64   `tfsdk:"limit"`
+	Launches    []LaunchModel `tfsdk:"launches"`
+}
+
+func NewVariantLaunchesDataSource() datasource.DataSource {
+	return &VariantLaunchesDataSource{}
+}
+
+func (d *VariantLaunchesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variant_launches"
+}
+
+func (d *VariantLaunchesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := launchAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "ID of the launch",
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Provide the launch history of a specific graph variant, newest first",
+		Attributes: map[string]schema.Attribute{
+			"graph_id": schema.StringAttribute{
+				Description: "ID of the graph",
+				Required:    true,
+			},
+			"variant_name": schema.StringAttribute{
+				Description: "Name of the variant",
+				Required:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of launches to return. Defaults to `10`",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"launches": schema.ListNestedAttribute{
+				Description: "List of launches",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: attributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *VariantLaunchesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.ApolloClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.ApolloClient got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VariantLaunchesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VariantLaunchesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := 10
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	launches, err := d.client.GetLaunchHistory(ctx, data.GraphId.ValueString(), data.VariantName.ValueString(), limit)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to get launches for the given variant",
+			fmt.Sprintf("Failed to get launches for the given variant: %s", err.Error()),
+		)
+		return
+	}
+
+	data.Launches = make([]LaunchModel, 0, len(launches))
+	for _, launch := range launches {
+		data.Launches = append(data.Launches, newLaunchModel(launch))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
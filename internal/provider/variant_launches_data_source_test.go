@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVariantLaunchesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "apollostudio_variant_launches" "this" {
+						graph_id     = "testacc-terraform"
+						variant_name = "current"
+						limit        = 1
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.apollostudio_variant_launches.this", "launches.#", "1"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_variant_launches.this", "launches.0.id"),
+					resource.TestCheckResourceAttrSet("data.apollostudio_variant_launches.this", "launches.0.status"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type ClientFilter struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type OperationNameFilter struct {
+	Name string `json:"name"`
+}
+
+type ExcludedOperation struct {
+	Id string `json:"id"`
+}
+
+type CheckConfiguration struct {
+	Id                                string                `json:"id"`
+	TimeRangeSeconds                  int64                 `json:"timeRangeSeconds"`
+	OperationCountThreshold           int                   `json:"operationCountThreshold"`
+	OperationCountThresholdPercentage float64               `json:"operationCountThresholdPercentage"`
+	IncludeBaseVariant                bool                  `json:"includeBaseVariant"`
+	IncludedVariants                  []string              `json:"includedVariants"`
+	ExcludedClients                   []ClientFilter        `json:"excludedClients"`
+	ExcludedOperations                []ExcludedOperation   `json:"excludedOperations"`
+	ExcludedOperationNames            []OperationNameFilter `json:"excludedOperationNames"`
+	DownstreamVariantsToCheck         []string              `json:"downstreamVariantsToCheck"`
+	ProposalChangeMismatchSeverity    string                `json:"proposalChangeMismatchSeverity"`
+}
+
+func (c *ApolloClient) GetCheckConfiguration(ctx context.Context, graphId string) (CheckConfiguration, error) {
+	var query struct {
+		Graph struct {
+			CheckConfiguration CheckConfiguration
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return CheckConfiguration{}, err
+	}
+	return query.Graph.CheckConfiguration, nil
+}
+
+// UpdateCheckConfiguration replaces the default configuration of the checks run against a graph.
+// Lists are replaced as a whole, an empty list clears the setting.
+func (c *ApolloClient) UpdateCheckConfiguration(ctx context.Context, graphId string, config CheckConfiguration) (CheckConfiguration, error) {
+	var mutation struct {
+		Graph struct {
+			UpdateCheckConfiguration CheckConfiguration `graphql:"updateCheckConfiguration(timeRangeSeconds: $timeRangeSeconds, operationCountThreshold: $operationCountThreshold, operationCountThresholdPercentage: $operationCountThresholdPercentage, includeBaseVariant: $includeBaseVariant, includedVariants: $includedVariants, excludedClients: $excludedClients, excludedOperations: $excludedOperations, excludedOperationNames: $excludedOperationNames, downstreamVariantsToCheck: $downstreamVariantsToCheck, proposalChangeMismatchSeverity: $proposalChangeMismatchSeverity)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+
+	includedVariants := make([]graphql.String, 0, len(config.IncludedVariants))
+	for _, variantName := range config.IncludedVariants {
+		includedVariants = append(includedVariants, graphql.String(variantName))
+	}
+	downstreamVariants := make([]graphql.String, 0, len(config.DownstreamVariantsToCheck))
+	for _, variantName := range config.DownstreamVariantsToCheck {
+		downstreamVariants = append(downstreamVariants, graphql.String(variantName))
+	}
+	excludedClients := make([]ClientFilterInput, 0, len(config.ExcludedClients))
+	for _, clientFilter := range config.ExcludedClients {
+		excludedClients = append(excludedClients, NewClientFilterInput(clientFilter.Name, clientFilter.Version))
+	}
+	excludedOperations := make([]ExcludedOperationInput, 0, len(config.ExcludedOperations))
+	for _, operation := range config.ExcludedOperations {
+		excludedOperations = append(excludedOperations, ExcludedOperationInput{Id: operation.Id})
+	}
+	excludedOperationNames := make([]OperationNameFilterInput, 0, len(config.ExcludedOperationNames))
+	for _, operationName := range config.ExcludedOperationNames {
+		excludedOperationNames = append(excludedOperationNames, OperationNameFilterInput{Name: operationName.Name})
+	}
+
+	vars := map[string]interface{}{
+		"graphId":                           graphql.ID(graphId),
+		"timeRangeSeconds":                  Long(config.TimeRangeSeconds),
+		"operationCountThreshold":           graphql.Int(config.OperationCountThreshold),
+		"operationCountThresholdPercentage": graphql.Float(config.OperationCountThresholdPercentage),
+		"includeBaseVariant":                graphql.Boolean(config.IncludeBaseVariant),
+		"includedVariants":                  includedVariants,
+		"excludedClients":                   excludedClients,
+		"excludedOperations":                excludedOperations,
+		"excludedOperationNames":            excludedOperationNames,
+		"downstreamVariantsToCheck":         downstreamVariants,
+		"proposalChangeMismatchSeverity":    ProposalChangeMismatchSeverity(config.ProposalChangeMismatchSeverity),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return CheckConfiguration{}, err
+	}
+	return mutation.Graph.UpdateCheckConfiguration, nil
+}
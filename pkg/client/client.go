@@ -1,6 +1,7 @@
 package client
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/hasura/go-graphql-client"
@@ -19,3 +20,22 @@ func NewClient(host string, apiKey string, orgId string) *ApolloClient {
 		}),
 	}
 }
+
+// resultError builds an error from the message of an error member of a union result.
+func resultError(typename string, messages ...string) error {
+	for _, message := range messages {
+		if message != "" {
+			return fmt.Errorf("%s: %s", typename, message)
+		}
+	}
+	return fmt.Errorf("unexpected result %s", typename)
+}
+
+// optionalString maps an empty string to a null GraphQL variable.
+func optionalString(value string) *graphql.String {
+	if value == "" {
+		return nil
+	}
+	s := graphql.String(value)
+	return &s
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type FieldUsage struct {
+	GroupBy struct {
+		ParentType    string
+		FieldName     string
+		ClientName    string
+		ClientVersion string
+	}
+	Metrics struct {
+		EstimatedExecutionCount   int64
+		ReferencingOperationCount int64
+	}
+}
+
+type DeprecatedField struct {
+	ParentType        string
+	FieldName         string
+	DeprecationReason string
+}
+
+// GetFieldUsage returns the usage of the fields of a variant between two dates, grouped by field and client.
+func (c *ApolloClient) GetFieldUsage(ctx context.Context, graphId string, variantName string, from Timestamp, to Timestamp) ([]FieldUsage, error) {
+	var query struct {
+		Graph struct {
+			StatsWindow struct {
+				FieldUsage []FieldUsage `graphql:"fieldUsage(filter: $filter)"`
+			} `graphql:"statsWindow(from: $from, to: $to)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+		"from":    from,
+		"to":      to,
+		"filter":  FieldUsageFilter{SchemaTag: variantName},
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return query.Graph.StatsWindow.FieldUsage, nil
+}
+
+// GetDeprecatedFields returns the fields and enum values marked as deprecated in the latest schema published on a variant.
+// Deprecated arguments and input fields aren't returned.
+func (c *ApolloClient) GetDeprecatedFields(ctx context.Context, graphId string, variantName string) ([]DeprecatedField, error) {
+	var query struct {
+		Graph struct {
+			Variant struct {
+				LatestPublication struct {
+					Schema struct {
+						Introspection struct {
+							Types []struct {
+								Name   string
+								Fields []struct {
+									Name              string
+									IsDeprecated      bool
+									DeprecationReason string
+								} `graphql:"fields(includeDeprecated: true)"`
+								EnumValues []struct {
+									Name              string
+									IsDeprecated      bool
+									DeprecationReason string
+								} `graphql:"enumValues(includeDeprecated: true)"`
+							}
+						}
+					}
+				}
+			} `graphql:"variant(name: $variantName)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"variantName": graphql.String(variantName),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return nil, err
+	}
+	deprecatedFields := make([]DeprecatedField, 0)
+	for _, schemaType := range query.Graph.Variant.LatestPublication.Schema.Introspection.Types {
+		for _, field := range schemaType.Fields {
+			if field.IsDeprecated {
+				deprecatedFields = append(deprecatedFields, DeprecatedField{
+					ParentType:        schemaType.Name,
+					FieldName:         field.Name,
+					DeprecationReason: field.DeprecationReason,
+				})
+			}
+		}
+		for _, enumValue := range schemaType.EnumValues {
+			if enumValue.IsDeprecated {
+				deprecatedFields = append(deprecatedFields, DeprecatedField{
+					ParentType:        schemaType.Name,
+					FieldName:         enumValue.Name,
+					DeprecationReason: enumValue.DeprecationReason,
+				})
+			}
+		}
+	}
+	return deprecatedFields, nil
+}
@@ -7,12 +7,13 @@ import (
 )
 
 type Graph struct {
-	Id               string
-	Name             string
-	Description      string
-	GraphType        string
-	ReportingEnabled bool
-	AccountId        string
+	Id                                        string
+	Name                                      string
+	Description                               string
+	GraphType                                 string
+	ReportingEnabled                          bool
+	HiddenFromUninvitedNonAdminAccountMembers bool
+	AccountId                                 string
 }
 
 func (c *ApolloClient) GetGraphs(ctx context.Context) ([]Graph, error) {
@@ -31,6 +32,28 @@ func (c *ApolloClient) GetGraphs(ctx context.Context) ([]Graph, error) {
 	return query.Organization.Graphs, nil
 }
 
+type GraphWithVariants struct {
+	Graph
+	Variants []GraphVariant
+}
+
+// GetGraphsWithVariants returns the graphs of the organization along with their variants.
+func (c *ApolloClient) GetGraphsWithVariants(ctx context.Context) ([]GraphWithVariants, error) {
+	var query struct {
+		Organization struct {
+			Graphs []GraphWithVariants
+		} `graphql:"organization(id: $orgId)"`
+	}
+	vars := map[string]interface{}{
+		"orgId": graphql.ID(c.orgId),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return query.Organization.Graphs, nil
+}
+
 func (c *ApolloClient) GetGraph(ctx context.Context, graphId string) (Graph, error) {
 	var query struct {
 		Graph Graph `graphql:"graph(id: $graphId)"`
@@ -45,15 +68,24 @@ func (c *ApolloClient) GetGraph(ctx context.Context, graphId string) (Graph, err
 	return query.Graph, nil
 }
 
-func (c *ApolloClient) CreateGraph(ctx context.Context, id string, name string, description string) (Graph, error) {
+// CreateGraph creates a graph, the type of the graph and its visibility are chosen by the API when
+// graphType and hidden are nil.
+func (c *ApolloClient) CreateGraph(ctx context.Context, id string, name string, description string, graphType *GraphType, hidden *bool) (Graph, error) {
 	var mutation struct {
-		NewService Graph `graphql:"newService(accountId: $accountId, id: $id, name: $name, description: $description)"`
+		NewService Graph `graphql:"newService(accountId: $accountId, id: $id, name: $name, description: $description, graphType: $graphType, hiddenFromUninvitedNonAdminAccountMembers: $hidden)"`
+	}
+	var hiddenValue *graphql.Boolean
+	if hidden != nil {
+		value := graphql.Boolean(*hidden)
+		hiddenValue = &value
 	}
 	vars := map[string]interface{}{
 		"accountId":   graphql.ID(c.orgId),
 		"id":          graphql.ID(id),
 		"name":        graphql.String(name),
 		"description": graphql.String(description),
+		"graphType":   graphType,
+		"hidden":      hiddenValue,
 	}
 	err := c.gqlClient.Mutate(ctx, &mutation, vars)
 	if err != nil {
@@ -117,3 +149,42 @@ func (c *ApolloClient) UpdateGraphDescription(ctx context.Context, graphId strin
 	}
 	return nil
 }
+
+func (c *ApolloClient) UpdateGraphReportingEnabled(ctx context.Context, graphId string, reportingEnabled bool) error {
+	var mutation struct {
+		Graph struct {
+			UpdateReportingEnabled struct {
+				Id string
+			} `graphql:"updateReportingEnabled(reportingEnabled: $reportingEnabled)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":          graphql.ID(graphId),
+		"reportingEnabled": graphql.Boolean(reportingEnabled),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateGraphHiddenFromUninvitedMembers hides the graph from the organization members that weren't invited to it.
+func (c *ApolloClient) UpdateGraphHiddenFromUninvitedMembers(ctx context.Context, graphId string, hidden bool) error {
+	var mutation struct {
+		Graph struct {
+			UpdateHiddenFromUninvitedNonAdminAccountMembers struct {
+				Id string
+			} `graphql:"updateHiddenFromUninvitedNonAdminAccountMembers(hiddenFromUninvitedNonAdminAccountMembers: $hidden)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+		"hidden":  graphql.Boolean(hidden),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}
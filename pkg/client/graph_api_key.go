@@ -43,15 +43,16 @@ func (c *ApolloClient) GetGraphApiKey(ctx context.Context, graphId string, apiKe
 	return GraphApiKey{}, nil
 }
 
-func (c *ApolloClient) CreateGraphApiKey(ctx context.Context, graphId string, keyName string) (GraphApiKey, error) {
+func (c *ApolloClient) CreateGraphApiKey(ctx context.Context, graphId string, keyName string, role UserPermission) (GraphApiKey, error) {
 	var mutation struct {
 		Graph struct {
-			NewKey GraphApiKey `graphql:"newKey(keyName: $keyName)"`
+			NewKey GraphApiKey `graphql:"newKey(keyName: $keyName, role: $role)"`
 		} `graphql:"graph(id: $graphId)"`
 	}
 	vars := map[string]interface{}{
 		"graphId": graphql.ID(graphId),
 		"keyName": graphql.String(keyName),
+		"role":    role,
 	}
 	err := c.gqlClient.Mutate(ctx, &mutation, vars)
 	if err != nil {
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type QueryStats struct {
+	Timestamp string
+	GroupBy   struct {
+		ClientName    string
+		ClientVersion string
+		QueryId       string `graphql:"queryID"`
+	}
+	Metrics struct {
+		TotalRequestCount int64
+	}
+}
+
+// GetQueryStats returns the requests sent to a variant between two dates, grouped by day, client and operation.
+// The daily resolution keeps the number of rows bounded over long time windows.
+func (c *ApolloClient) GetQueryStats(ctx context.Context, graphId string, variantName string, from Timestamp, to Timestamp) ([]QueryStats, error) {
+	var query struct {
+		Graph struct {
+			StatsWindow struct {
+				QueryStats []QueryStats `graphql:"queryStats(filter: $filter)"`
+			} `graphql:"statsWindow(from: $from, to: $to, resolution: $resolution)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":    graphql.ID(graphId),
+		"from":       from,
+		"to":         to,
+		"resolution": ResolutionR1D,
+		"filter":     QueryStatsFilter{SchemaTag: variantName},
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return query.Graph.StatsWindow.QueryStats, nil
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type LinterRuleLevelConfiguration struct {
+	Rule  LintRule            `json:"rule"`
+	Level LintDiagnosticLevel `json:"level"`
+}
+
+type GraphLinterConfiguration struct {
+	IgnoreExistingViolations bool                           `json:"ignoreExistingViolations"`
+	IgnoredDirectives        []string                       `json:"ignoredDirectives"`
+	Rules                    []LinterRuleLevelConfiguration `json:"rules"`
+}
+
+func (c *ApolloClient) GetGraphLinterConfiguration(ctx context.Context, graphId string) (GraphLinterConfiguration, error) {
+	var query struct {
+		Graph struct {
+			LinterConfiguration GraphLinterConfiguration
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return GraphLinterConfiguration{}, err
+	}
+	return query.Graph.LinterConfiguration, nil
+}
+
+// UpdateGraphLinterConfiguration applies the changes to the linter configuration of a graph.
+// Rules missing from the changes keep their current level.
+func (c *ApolloClient) UpdateGraphLinterConfiguration(ctx context.Context, graphId string, changes GraphLinterConfigurationChangesInput) (GraphLinterConfiguration, error) {
+	var mutation struct {
+		Graph struct {
+			UpdateLinterConfiguration GraphLinterConfiguration `graphql:"updateLinterConfiguration(changes: $changes)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+		"changes": changes,
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return GraphLinterConfiguration{}, err
+	}
+	return mutation.Graph.UpdateLinterConfiguration, nil
+}
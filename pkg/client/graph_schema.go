@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type Schema struct {
+	Hash     string
+	Document string
+}
+
+type UploadSchemaResponse struct {
+	Code    string
+	Message string
+	Success bool
+	Tag     struct {
+		Schema struct {
+			Hash string
+		}
+	}
+}
+
+// GetGraphSchema returns the schema of the latest publication of a non-federated variant.
+// An empty hash means nothing has been published on the variant yet.
+func (c *ApolloClient) GetGraphSchema(ctx context.Context, graphId string, variantName string) (Schema, error) {
+	var query struct {
+		Graph struct {
+			Variant struct {
+				LatestPublication struct {
+					Schema Schema
+				}
+			} `graphql:"variant(name: $variantName)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"variantName": graphql.String(variantName),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return Schema{}, err
+	}
+	return query.Graph.Variant.LatestPublication.Schema, nil
+}
+
+// UploadGraphSchema publishes the full schema of a non-federated variant and returns its hash.
+func (c *ApolloClient) UploadGraphSchema(ctx context.Context, graphId string, variantName string, schema string) (string, error) {
+	var mutation struct {
+		Graph struct {
+			UploadSchema UploadSchemaResponse `graphql:"uploadSchema(schemaDocument: $schema, tag: $variantName)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"variantName": graphql.String(variantName),
+		"schema":      graphql.String(schema),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return "", err
+	}
+	uploadSchema := mutation.Graph.UploadSchema
+	if !uploadSchema.Success {
+		return "", fmt.Errorf("%s (code: %s)", uploadSchema.Message, uploadSchema.Code)
+	}
+	return uploadSchema.Tag.Schema.Hash, nil
+}
+
+// SubmitGraphSchemaCheck runs the checks of a non-federated variant against a proposed schema and returns the ID of the check workflow.
+func (c *ApolloClient) SubmitGraphSchemaCheck(ctx context.Context, graphId string, variantName string, schema string) (string, error) {
+	var mutation struct {
+		Graph struct {
+			Variant struct {
+				SubmitCheckSchemaAsync struct {
+					Typename            string `graphql:"__typename"`
+					CheckRequestSuccess struct {
+						TargetURL  string  `graphql:"targetURL"`
+						WorkflowID *string `graphql:"workflowID"`
+					} `graphql:"... on CheckRequestSuccess"`
+					InvalidInputError struct {
+						Message string
+					} `graphql:"... on InvalidInputError"`
+					PermissionError struct {
+						Message string
+					} `graphql:"... on PermissionError"`
+					PlanError struct {
+						Message string
+					} `graphql:"... on PlanError"`
+				} `graphql:"submitCheckSchemaAsync(input: $input)"`
+			} `graphql:"variant(name: $variantName)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"variantName": graphql.String(variantName),
+		"input": CheckSchemaAsyncInput{
+			IsSandbox:              false,
+			ProposedSchemaDocument: schema,
+			GitContext:             GitContextInput{},
+			Config:                 HistoricQueryParametersInput{},
+		},
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return "", err
+	}
+	result := mutation.Graph.Variant.SubmitCheckSchemaAsync
+	if result.CheckRequestSuccess.WorkflowID == nil {
+		return "", resultError(result.Typename, result.InvalidInputError.Message, result.PermissionError.Message, result.PlanError.Message)
+	}
+	return *result.CheckRequestSuccess.WorkflowID, nil
+}
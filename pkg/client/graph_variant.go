@@ -7,8 +7,12 @@ import (
 )
 
 type GraphVariant struct {
-	Id   string `json:"id"`
-	Name string `json:"name"`
+	Id                string `json:"id"`
+	Name              string `json:"name"`
+	Url               string `json:"url"`
+	LatestPublication struct {
+		PublishedAt string `json:"publishedAt"`
+	} `json:"latestPublication"`
 }
 
 func (c *ApolloClient) GetGraphVariants(ctx context.Context, graphId string) ([]GraphVariant, error) {
@@ -42,3 +46,101 @@ func (c *ApolloClient) GetGraphVariant(ctx context.Context, variantRef string) (
 	}
 	return query.Variant.GraphVariant, nil
 }
+
+type GraphVariantDetails struct {
+	Id                string
+	Name              string
+	Url               string
+	IsContract        bool
+	IsProtected       bool
+	IsPublic          bool
+	FederationVersion string
+	RouterConfig      string
+	SourceVariant     struct {
+		Id   string
+		Name string
+	}
+	LatestPublication struct {
+		PublishedAt string
+		Schema      struct {
+			Hash string
+		}
+	}
+	Subgraphs []struct {
+		Name string
+	}
+	LatestLaunch struct {
+		Id     string
+		Status LaunchStatus
+	}
+}
+
+// GetGraphVariantDetails returns the configuration and the latest publication and launch of a variant.
+// An empty id means the variant doesn't exist.
+func (c *ApolloClient) GetGraphVariantDetails(ctx context.Context, variantRef string) (GraphVariantDetails, error) {
+	var query struct {
+		Variant struct {
+			GraphVariant GraphVariantDetails `graphql:"... on GraphVariant"`
+		} `graphql:"variant(ref: $ref)"`
+	}
+	vars := map[string]interface{}{
+		"ref": graphql.ID(variantRef),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return GraphVariantDetails{}, err
+	}
+	details := query.Variant.GraphVariant
+	details.FederationVersion = NormalizeFederationVersion(details.FederationVersion)
+	return details, nil
+}
+
+type GraphVariantBuildConfig struct {
+	Id                string
+	FederationVersion string
+}
+
+// GetGraphVariantBuildConfig returns the build configuration of a variant.
+// An empty id means the variant doesn't exist.
+func (c *ApolloClient) GetGraphVariantBuildConfig(ctx context.Context, graphId string, variantName string) (GraphVariantBuildConfig, error) {
+	var query struct {
+		Graph struct {
+			Variant GraphVariantBuildConfig `graphql:"variant(name: $variantName)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"variantName": graphql.String(variantName),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return GraphVariantBuildConfig{}, err
+	}
+	buildConfig := query.Graph.Variant
+	buildConfig.FederationVersion = NormalizeFederationVersion(buildConfig.FederationVersion)
+	return buildConfig, nil
+}
+
+// UpdateGraphVariantFederationVersion updates the federation version used to compose the supergraph of a variant.
+// The version is given as `<major>.<minor>` (e.g. `2.3`).
+func (c *ApolloClient) UpdateGraphVariantFederationVersion(ctx context.Context, graphId string, variantName string, federationVersion string) (GraphVariantBuildConfig, error) {
+	var mutation struct {
+		Graph struct {
+			Variant struct {
+				UpdateVariantFederationVersion GraphVariantBuildConfig `graphql:"updateVariantFederationVersion(version: $version)"`
+			} `graphql:"variant(name: $variantName)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"variantName": graphql.String(variantName),
+		"version":     NewBuildPipelineTrack(federationVersion),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return GraphVariantBuildConfig{}, err
+	}
+	buildConfig := mutation.Graph.Variant.UpdateVariantFederationVersion
+	buildConfig.FederationVersion = NormalizeFederationVersion(buildConfig.FederationVersion)
+	return buildConfig, nil
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type LaunchSubgraph struct {
+	Name       string
+	Hash       string
+	RoutingURL string `graphql:"routingURL"`
+}
+
+type BuildError struct {
+	Code    string
+	Message string
+}
+
+type LaunchBuild struct {
+	Input struct {
+		CompositionBuildInput struct {
+			Subgraphs []LaunchSubgraph
+			Version   string
+		} `graphql:"... on CompositionBuildInput"`
+	}
+	Result struct {
+		BuildFailure struct {
+			ErrorMessages []BuildError
+		} `graphql:"... on BuildFailure"`
+	}
+}
+
+type DownstreamLaunch struct {
+	Id           string
+	GraphVariant string
+	Status       LaunchStatus
+}
+
+type Launch struct {
+	Id                 string
+	GraphVariant       string
+	Status             LaunchStatus
+	CreatedAt          string
+	ApprovedAt         string
+	CompletedAt        string
+	Build              LaunchBuild
+	DownstreamLaunches []DownstreamLaunch
+}
+
+// GetLatestLaunch returns the most recent launch of a variant.
+// An empty id means the variant doesn't exist or has never been launched.
+func (c *ApolloClient) GetLatestLaunch(ctx context.Context, graphId string, variantName string) (Launch, error) {
+	var query struct {
+		Graph struct {
+			Variant struct {
+				LatestLaunch Launch
+			} `graphql:"variant(name: $variantName)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"variantName": graphql.String(variantName),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return Launch{}, err
+	}
+	return query.Graph.Variant.LatestLaunch, nil
+}
+
+// GetLaunch returns a specific launch of a variant.
+// An empty id means the launch wasn't found.
+func (c *ApolloClient) GetLaunch(ctx context.Context, graphId string, variantName string, launchId string) (Launch, error) {
+	var query struct {
+		Graph struct {
+			Variant struct {
+				Launch Launch `graphql:"launch(id: $launchId)"`
+			} `graphql:"variant(name: $variantName)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"variantName": graphql.String(variantName),
+		"launchId":    graphql.ID(launchId),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return Launch{}, err
+	}
+	return query.Graph.Variant.Launch, nil
+}
+
+// GetLaunchHistory returns the most recent launches of a variant, newest first.
+func (c *ApolloClient) GetLaunchHistory(ctx context.Context, graphId string, variantName string, limit int) ([]Launch, error) {
+	var query struct {
+		Graph struct {
+			Variant struct {
+				LaunchHistory []Launch `graphql:"launchHistory(limit: $limit)"`
+			} `graphql:"variant(name: $variantName)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"variantName": graphql.String(variantName),
+		"limit":       graphql.Int(limit),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return query.Graph.Variant.LaunchHistory, nil
+}
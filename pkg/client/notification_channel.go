@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type NotificationChannel struct {
+	Typename     string `graphql:"__typename"`
+	Id           string
+	Name         string
+	SlackChannel struct {
+		Url string
+	} `graphql:"... on SlackChannel"`
+	WebhookChannel struct {
+		Url string
+	} `graphql:"... on WebhookChannel"`
+	EmailChannel struct {
+		Email string
+	} `graphql:"... on EmailChannel"`
+}
+
+// Type returns the type of the channel, one of the NotificationChannelType constants.
+func (n NotificationChannel) Type() NotificationChannelType {
+	switch n.Typename {
+	case "SlackChannel":
+		return NotificationChannelTypeSlack
+	case "WebhookChannel":
+		return NotificationChannelTypeWebhook
+	case "EmailChannel":
+		return NotificationChannelTypeEmail
+	}
+	return NotificationChannelType(n.Typename)
+}
+
+type NotificationChannelInput struct {
+	Name        string
+	Url         string
+	SecretToken string
+	Email       string
+}
+
+func (c *ApolloClient) GetNotificationChannels(ctx context.Context, graphId string) ([]NotificationChannel, error) {
+	var query struct {
+		Graph struct {
+			Channels []NotificationChannel
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return query.Graph.Channels, nil
+}
+
+// GetNotificationChannel returns a notification channel of a graph, an empty id means the channel doesn't exist.
+func (c *ApolloClient) GetNotificationChannel(ctx context.Context, graphId string, channelId string) (NotificationChannel, error) {
+	channels, err := c.GetNotificationChannels(ctx, graphId)
+	if err != nil {
+		return NotificationChannel{}, err
+	}
+	for _, channel := range channels {
+		if channel.Id == channelId {
+			return channel, nil
+		}
+	}
+	return NotificationChannel{}, nil
+}
+
+// CreateNotificationChannel creates a channel of the given type, only the fields of the input relevant to the type are used.
+func (c *ApolloClient) CreateNotificationChannel(ctx context.Context, graphId string, channelType NotificationChannelType, input NotificationChannelInput) (NotificationChannel, error) {
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+		"name":    graphql.String(input.Name),
+	}
+	switch channelType {
+	case NotificationChannelTypeSlack:
+		var mutation struct {
+			Graph struct {
+				CreateSlackChannel NotificationChannel `graphql:"createSlackChannel(channel: { name: $name, url: $url })"`
+			} `graphql:"graph(id: $graphId)"`
+		}
+		vars["url"] = graphql.String(input.Url)
+		err := c.gqlClient.Mutate(ctx, &mutation, vars)
+		return mutation.Graph.CreateSlackChannel, err
+	case NotificationChannelTypeWebhook:
+		var mutation struct {
+			Graph struct {
+				CreateWebhookChannel NotificationChannel `graphql:"createWebhookChannel(name: $name, url: $url, secretToken: $secretToken)"`
+			} `graphql:"graph(id: $graphId)"`
+		}
+		vars["url"] = graphql.String(input.Url)
+		vars["secretToken"] = optionalString(input.SecretToken)
+		err := c.gqlClient.Mutate(ctx, &mutation, vars)
+		return mutation.Graph.CreateWebhookChannel, err
+	case NotificationChannelTypeEmail:
+		var mutation struct {
+			Graph struct {
+				CreateEmailChannel NotificationChannel `graphql:"createEmailChannel(name: $name, email: $email)"`
+			} `graphql:"graph(id: $graphId)"`
+		}
+		vars["email"] = graphql.String(input.Email)
+		err := c.gqlClient.Mutate(ctx, &mutation, vars)
+		return mutation.Graph.CreateEmailChannel, err
+	}
+	return NotificationChannel{}, fmt.Errorf("unsupported notification channel type %s", channelType)
+}
+
+// UpdateNotificationChannel updates a channel of the given type, the type of a channel can't be changed.
+func (c *ApolloClient) UpdateNotificationChannel(ctx context.Context, graphId string, channelId string, channelType NotificationChannelType, input NotificationChannelInput) (NotificationChannel, error) {
+	vars := map[string]interface{}{
+		"graphId":   graphql.ID(graphId),
+		"channelId": graphql.ID(channelId),
+		"name":      graphql.String(input.Name),
+	}
+	switch channelType {
+	case NotificationChannelTypeSlack:
+		var mutation struct {
+			Graph struct {
+				UpdateSlackChannel NotificationChannel `graphql:"updateSlackChannel(id: $channelId, channel: { name: $name, url: $url })"`
+			} `graphql:"graph(id: $graphId)"`
+		}
+		vars["url"] = graphql.String(input.Url)
+		err := c.gqlClient.Mutate(ctx, &mutation, vars)
+		return mutation.Graph.UpdateSlackChannel, err
+	case NotificationChannelTypeWebhook:
+		var mutation struct {
+			Graph struct {
+				UpdateWebhookChannel NotificationChannel `graphql:"updateWebhookChannel(id: $channelId, name: $name, url: $url, secretToken: $secretToken)"`
+			} `graphql:"graph(id: $graphId)"`
+		}
+		vars["url"] = graphql.String(input.Url)
+		vars["secretToken"] = optionalString(input.SecretToken)
+		err := c.gqlClient.Mutate(ctx, &mutation, vars)
+		return mutation.Graph.UpdateWebhookChannel, err
+	case NotificationChannelTypeEmail:
+		var mutation struct {
+			Graph struct {
+				UpdateEmailChannel NotificationChannel `graphql:"updateEmailChannel(id: $channelId, name: $name, email: $email)"`
+			} `graphql:"graph(id: $graphId)"`
+		}
+		vars["email"] = graphql.String(input.Email)
+		err := c.gqlClient.Mutate(ctx, &mutation, vars)
+		return mutation.Graph.UpdateEmailChannel, err
+	}
+	return NotificationChannel{}, fmt.Errorf("unsupported notification channel type %s", channelType)
+}
+
+func (c *ApolloClient) DeleteNotificationChannel(ctx context.Context, graphId string, channelId string) error {
+	var mutation struct {
+		Graph struct {
+			DeleteChannel bool `graphql:"deleteChannel(id: $channelId)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":   graphql.ID(graphId),
+		"channelId": graphql.ID(channelId),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}
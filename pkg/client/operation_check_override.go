@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type OperationSafetyResult struct {
+	Success            bool
+	Message            string
+	AffectedOperations []AffectedQuery
+}
+
+// MarkChangesForOperationAsSafe approves the changes found by a check for an operation, future checks don't flag them anymore.
+// It returns the operation the changes were approved for.
+func (c *ApolloClient) MarkChangesForOperationAsSafe(ctx context.Context, graphId string, checkId string, operationId string) (AffectedQuery, error) {
+	var mutation struct {
+		Graph struct {
+			MarkChangesForOperationAsSafe OperationSafetyResult `graphql:"markChangesForOperationAsSafe(checkID: $checkId, operationID: $operationId)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"checkId":     graphql.ID(checkId),
+		"operationId": graphql.ID(operationId),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return AffectedQuery{}, err
+	}
+	return affectedOperation(mutation.Graph.MarkChangesForOperationAsSafe, operationId)
+}
+
+func (c *ApolloClient) UnmarkChangesForOperationAsSafe(ctx context.Context, graphId string, checkId string, operationId string) error {
+	var mutation struct {
+		Graph struct {
+			UnmarkChangesForOperationAsSafe OperationSafetyResult `graphql:"unmarkChangesForOperationAsSafe(checkID: $checkId, operationID: $operationId)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"checkId":     graphql.ID(checkId),
+		"operationId": graphql.ID(operationId),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	_, err = affectedOperation(mutation.Graph.UnmarkChangesForOperationAsSafe, operationId)
+	return err
+}
+
+// affectedOperation returns the operation affected by a safety change, or an error when the change failed.
+func affectedOperation(result OperationSafetyResult, operationId string) (AffectedQuery, error) {
+	if !result.Success {
+		return AffectedQuery{}, resultError("OperationSafetyResult", result.Message)
+	}
+	for _, operation := range result.AffectedOperations {
+		if operation.Id == operationId {
+			return operation, nil
+		}
+	}
+	return AffectedQuery{}, fmt.Errorf("operation %s wasn't affected by the check", operationId)
+}
+
+// IgnoreOperationsInChecks excludes operations from the future checks of a graph.
+func (c *ApolloClient) IgnoreOperationsInChecks(ctx context.Context, graphId string, operationIds []string) error {
+	var mutation struct {
+		Graph struct {
+			IgnoreOperationsInChecks struct {
+				Graph struct {
+					Id string
+				}
+			} `graphql:"ignoreOperationsInChecks(ids: $ids)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	ids := make([]graphql.ID, 0, len(operationIds))
+	for _, id := range operationIds {
+		ids = append(ids, graphql.ID(id))
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+		"ids":     ids,
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *ApolloClient) UnignoreOperationsInChecks(ctx context.Context, graphId string, operationIds []string) error {
+	var mutation struct {
+		Graph struct {
+			UnignoreOperationsInChecks struct {
+				Graph struct {
+					Id string
+				}
+			} `graphql:"unignoreOperationsInChecks(ids: $ids)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	ids := make([]graphql.ID, 0, len(operationIds))
+	for _, id := range operationIds {
+		ids = append(ids, graphql.ID(id))
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+		"ids":     ids,
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}
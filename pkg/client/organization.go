@@ -24,3 +24,158 @@ func (c *ApolloClient) GetOrganization(ctx context.Context) (Organization, error
 	}
 	return query.Organization, nil
 }
+
+type OrganizationMember struct {
+	Permission UserPermission
+	CreatedAt  string
+	User       struct {
+		Id                  string
+		Name                string
+		Email               string
+		LastAuthenticatedAt string
+	}
+}
+
+type OrganizationInvitation struct {
+	Id         string
+	Email      string
+	Role       UserPermission
+	CreatedAt  string
+	AcceptedAt string
+}
+
+func (c *ApolloClient) GetOrganizationMembers(ctx context.Context) ([]OrganizationMember, error) {
+	var query struct {
+		Organization struct {
+			Memberships []OrganizationMember
+		} `graphql:"organization(id: $orgId)"`
+	}
+	vars := map[string]interface{}{
+		"orgId": graphql.ID(c.orgId),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return query.Organization.Memberships, nil
+}
+
+// GetOrganizationMember returns a member of the organization, an empty user id means the user isn't a member.
+func (c *ApolloClient) GetOrganizationMember(ctx context.Context, userId string) (OrganizationMember, error) {
+	members, err := c.GetOrganizationMembers(ctx)
+	if err != nil {
+		return OrganizationMember{}, err
+	}
+	for _, member := range members {
+		if member.User.Id == userId {
+			return member, nil
+		}
+	}
+	return OrganizationMember{}, nil
+}
+
+func (c *ApolloClient) UpdateOrganizationMemberRole(ctx context.Context, userId string, role UserPermission) error {
+	var mutation struct {
+		Organization struct {
+			UpdateUserPermission struct {
+				Permission UserPermission
+			} `graphql:"updateUserPermission(userID: $userId, permission: $role)"`
+		} `graphql:"organization(id: $orgId)"`
+	}
+	vars := map[string]interface{}{
+		"orgId":  graphql.ID(c.orgId),
+		"userId": graphql.ID(userId),
+		"role":   role,
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *ApolloClient) RemoveOrganizationMember(ctx context.Context, userId string) error {
+	var mutation struct {
+		Organization struct {
+			RemoveMember struct {
+				Id string
+			} `graphql:"removeMember(id: $userId)"`
+		} `graphql:"organization(id: $orgId)"`
+	}
+	vars := map[string]interface{}{
+		"orgId":  graphql.ID(c.orgId),
+		"userId": graphql.ID(userId),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *ApolloClient) GetOrganizationInvitations(ctx context.Context) ([]OrganizationInvitation, error) {
+	var query struct {
+		Organization struct {
+			Invitations []OrganizationInvitation
+		} `graphql:"organization(id: $orgId)"`
+	}
+	vars := map[string]interface{}{
+		"orgId": graphql.ID(c.orgId),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return query.Organization.Invitations, nil
+}
+
+// GetOrganizationInvitation returns a pending invitation, an empty id means the invitation was either accepted or removed.
+func (c *ApolloClient) GetOrganizationInvitation(ctx context.Context, invitationId string) (OrganizationInvitation, error) {
+	invitations, err := c.GetOrganizationInvitations(ctx)
+	if err != nil {
+		return OrganizationInvitation{}, err
+	}
+	for _, invitation := range invitations {
+		if invitation.Id == invitationId {
+			return invitation, nil
+		}
+	}
+	return OrganizationInvitation{}, nil
+}
+
+func (c *ApolloClient) InviteOrganizationMember(ctx context.Context, email string, role UserPermission) (OrganizationInvitation, error) {
+	var mutation struct {
+		Organization struct {
+			InviteUser OrganizationInvitation `graphql:"inviteUser(email: $email, role: $role)"`
+		} `graphql:"organization(id: $orgId)"`
+	}
+	vars := map[string]interface{}{
+		"orgId": graphql.ID(c.orgId),
+		"email": graphql.String(email),
+		"role":  role,
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return OrganizationInvitation{}, err
+	}
+	return mutation.Organization.InviteUser, nil
+}
+
+func (c *ApolloClient) RemoveOrganizationInvitation(ctx context.Context, invitationId string) error {
+	var mutation struct {
+		Organization struct {
+			RemoveInvitation struct {
+				Id string
+			} `graphql:"removeInvitation(id: $invitationId)"`
+		} `graphql:"organization(id: $orgId)"`
+	}
+	vars := map[string]interface{}{
+		"orgId":        graphql.ID(c.orgId),
+		"invitationId": graphql.ID(invitationId),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type OrganizationApiKey struct {
+	Id        string
+	KeyName   string
+	Role      string
+	Token     string
+	CreatedAt string
+}
+
+func (c *ApolloClient) GetOrganizationApiKeys(ctx context.Context) ([]OrganizationApiKey, error) {
+	var query struct {
+		Organization struct {
+			ApiKeys []OrganizationApiKey
+		} `graphql:"organization(id: $orgId)"`
+	}
+	vars := map[string]interface{}{
+		"orgId": graphql.ID(c.orgId),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return query.Organization.ApiKeys, nil
+}
+
+func (c *ApolloClient) GetOrganizationApiKey(ctx context.Context, apiKeyId string) (OrganizationApiKey, error) {
+	apiKeys, err := c.GetOrganizationApiKeys(ctx)
+	if err != nil {
+		return OrganizationApiKey{}, err
+	}
+	for _, ak := range apiKeys {
+		if ak.Id == apiKeyId {
+			return ak, nil
+		}
+	}
+	return OrganizationApiKey{}, nil
+}
+
+func (c *ApolloClient) CreateOrganizationApiKey(ctx context.Context, keyName string, role UserPermission) (OrganizationApiKey, error) {
+	var mutation struct {
+		Organization struct {
+			NewKey OrganizationApiKey `graphql:"newKey(keyName: $keyName, role: $role)"`
+		} `graphql:"organization(id: $orgId)"`
+	}
+	vars := map[string]interface{}{
+		"orgId":   graphql.ID(c.orgId),
+		"keyName": graphql.String(keyName),
+		"role":    role,
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return OrganizationApiKey{}, err
+	}
+	return mutation.Organization.NewKey, nil
+}
+
+func (c *ApolloClient) RenameOrganizationApiKey(ctx context.Context, apiKeyId string, newKeyName string) error {
+	var mutation struct {
+		Organization struct {
+			RenameKey struct {
+				Id      string
+				KeyName string
+			} `graphql:"renameKey(id: $apiKeyId, newKeyName: $keyName)"`
+		} `graphql:"organization(id: $orgId)"`
+	}
+	vars := map[string]interface{}{
+		"orgId":    graphql.ID(c.orgId),
+		"apiKeyId": graphql.ID(apiKeyId),
+		"keyName":  graphql.String(newKeyName),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *ApolloClient) RemoveOrganizationApiKey(ctx context.Context, apiKeyId string) error {
+	var mutation struct {
+		Organization struct {
+			RemoveKey string `graphql:"removeKey(id: $apiKeyId)"`
+		} `graphql:"organization(id: $orgId)"`
+	}
+	vars := map[string]interface{}{
+		"orgId":    graphql.ID(c.orgId),
+		"apiKeyId": graphql.ID(apiKeyId),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type QueryTrigger struct {
+	Id                     string
+	Variant                string
+	Metric                 QueryTriggerMetric
+	Threshold              float64
+	ThresholdType          QueryTriggerThresholdType
+	Window                 QueryTriggerWindow
+	Scope                  QueryTriggerScope
+	OperationNames         []string
+	ExcludedOperationNames []string
+	Enabled                bool
+	Channels               []struct {
+		Id string
+	}
+}
+
+func (c *ApolloClient) GetPerformanceAlerts(ctx context.Context, graphId string) ([]QueryTrigger, error) {
+	var query struct {
+		Graph struct {
+			QueryTriggers []QueryTrigger
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return query.Graph.QueryTriggers, nil
+}
+
+// GetPerformanceAlert returns an alert of a graph, an empty id means the alert doesn't exist.
+func (c *ApolloClient) GetPerformanceAlert(ctx context.Context, graphId string, alertId string) (QueryTrigger, error) {
+	alerts, err := c.GetPerformanceAlerts(ctx, graphId)
+	if err != nil {
+		return QueryTrigger{}, err
+	}
+	for _, alert := range alerts {
+		if alert.Id == alertId {
+			return alert, nil
+		}
+	}
+	return QueryTrigger{}, nil
+}
+
+// UpsertPerformanceAlert creates an alert when alertId is empty, otherwise it updates the existing one.
+func (c *ApolloClient) UpsertPerformanceAlert(ctx context.Context, graphId string, alertId string, input QueryTriggerInput) (QueryTrigger, error) {
+	var mutation struct {
+		Graph struct {
+			UpsertQueryTrigger QueryTrigger `graphql:"upsertQueryTrigger(triggerId: $alertId, input: $input)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	var id *graphql.ID
+	if alertId != "" {
+		value := graphql.ID(alertId)
+		id = &value
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+		"alertId": id,
+		"input":   input,
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return QueryTrigger{}, err
+	}
+	return mutation.Graph.UpsertQueryTrigger, nil
+}
+
+func (c *ApolloClient) DeletePerformanceAlert(ctx context.Context, graphId string, alertId string) error {
+	var mutation struct {
+		Graph struct {
+			DeleteQueryTrigger bool `graphql:"deleteQueryTrigger(id: $alertId)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+		"alertId": graphql.ID(alertId),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+const PersistedQueryManifestFormat = "apollo-persisted-query-manifest"
+
+type PersistedQueryList struct {
+	Id             string
+	Name           string
+	Description    string
+	LinkedVariants []struct {
+		Name string
+	}
+}
+
+type PersistedQueryListBuild struct {
+	Revision int
+}
+
+type PersistedQueryInput struct {
+	Id         string  `json:"id"`
+	Name       string  `json:"name"`
+	Type       string  `json:"type"`
+	Body       string  `json:"body"`
+	ClientName *string `json:"clientName,omitempty"`
+}
+
+type PersistedQueryManifest struct {
+	Format     string                `json:"format"`
+	Version    int                   `json:"version"`
+	Operations []PersistedQueryInput `json:"operations"`
+}
+
+// ParsePersistedQueryManifest parses a manifest generated by `generate-persisted-query-manifest`.
+// Operation types are upper-cased to match the GraphQL `OperationType` enum.
+func ParsePersistedQueryManifest(manifest string) ([]PersistedQueryInput, error) {
+	var parsed PersistedQueryManifest
+	if err := json.Unmarshal([]byte(manifest), &parsed); err != nil {
+		return nil, err
+	}
+	if parsed.Format != PersistedQueryManifestFormat {
+		return nil, fmt.Errorf("unsupported manifest format %q, expected %q", parsed.Format, PersistedQueryManifestFormat)
+	}
+	if parsed.Version != 1 {
+		return nil, fmt.Errorf("unsupported manifest version %d, expected 1", parsed.Version)
+	}
+	operations := make([]PersistedQueryInput, 0, len(parsed.Operations))
+	for _, operation := range parsed.Operations {
+		if operation.Id == "" {
+			return nil, fmt.Errorf("operation %q has no id", operation.Name)
+		}
+		operation.Type = strings.ToUpper(operation.Type)
+		operations = append(operations, operation)
+	}
+	return operations, nil
+}
+
+func (c *ApolloClient) GetPersistedQueryList(ctx context.Context, graphId string, listId string) (PersistedQueryList, error) {
+	var query struct {
+		Graph struct {
+			PersistedQueryList PersistedQueryList `graphql:"persistedQueryList(id: $listId)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+		"listId":  graphql.ID(listId),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return PersistedQueryList{}, err
+	}
+	return query.Graph.PersistedQueryList, nil
+}
+
+func (c *ApolloClient) CreatePersistedQueryList(ctx context.Context, graphId string, name string, description string) (PersistedQueryList, error) {
+	var mutation struct {
+		Graph struct {
+			CreatePersistedQueryList struct {
+				Typename                       string `graphql:"__typename"`
+				CreatePersistedQueryListResult struct {
+					PersistedQueryList PersistedQueryList
+				} `graphql:"... on CreatePersistedQueryListResult"`
+				PermissionError struct {
+					Message string
+				} `graphql:"... on PermissionError"`
+				ValidationError struct {
+					Message string
+				} `graphql:"... on ValidationError"`
+			} `graphql:"createPersistedQueryList(name: $name, description: $description)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"name":        graphql.String(name),
+		"description": graphql.String(description),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return PersistedQueryList{}, err
+	}
+	result := mutation.Graph.CreatePersistedQueryList
+	if result.CreatePersistedQueryListResult.PersistedQueryList.Id == "" {
+		return PersistedQueryList{}, resultError(result.Typename, result.PermissionError.Message, result.ValidationError.Message)
+	}
+	return result.CreatePersistedQueryListResult.PersistedQueryList, nil
+}
+
+func (c *ApolloClient) UpdatePersistedQueryList(ctx context.Context, graphId string, listId string, name string, description string) error {
+	var mutation struct {
+		Graph struct {
+			PersistedQueryList struct {
+				UpdateMetadata struct {
+					Typename                               string `graphql:"__typename"`
+					UpdatePersistedQueryListMetadataResult struct {
+						PersistedQueryList struct {
+							Id string
+						}
+					} `graphql:"... on UpdatePersistedQueryListMetadataResult"`
+					PermissionError struct {
+						Message string
+					} `graphql:"... on PermissionError"`
+				} `graphql:"updateMetadata(name: $name, description: $description)"`
+			} `graphql:"persistedQueryList(id: $listId)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"listId":      graphql.ID(listId),
+		"name":        graphql.String(name),
+		"description": graphql.String(description),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	result := mutation.Graph.PersistedQueryList.UpdateMetadata
+	if result.UpdatePersistedQueryListMetadataResult.PersistedQueryList.Id == "" {
+		return resultError(result.Typename, result.PermissionError.Message)
+	}
+	return nil
+}
+
+func (c *ApolloClient) RemovePersistedQueryList(ctx context.Context, graphId string, listId string) error {
+	var mutation struct {
+		Graph struct {
+			PersistedQueryList struct {
+				Delete struct {
+					Typename        string `graphql:"__typename"`
+					PermissionError struct {
+						Message string
+					} `graphql:"... on PermissionError"`
+					CannotDeleteLinkedPersistedQueryListError struct {
+						Message string
+					} `graphql:"... on CannotDeleteLinkedPersistedQueryListError"`
+				}
+			} `graphql:"persistedQueryList(id: $listId)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+		"listId":  graphql.ID(listId),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	result := mutation.Graph.PersistedQueryList.Delete
+	if result.Typename != "DeletePersistedQueryListResult" {
+		return resultError(result.Typename, result.PermissionError.Message, result.CannotDeleteLinkedPersistedQueryListError.Message)
+	}
+	return nil
+}
+
+func (c *ApolloClient) LinkPersistedQueryList(ctx context.Context, graphId string, variantName string, listId string) error {
+	var mutation struct {
+		Graph struct {
+			Variant struct {
+				LinkPersistedQueryList struct {
+					Typename        string `graphql:"__typename"`
+					PermissionError struct {
+						Message string
+					} `graphql:"... on PermissionError"`
+					VariantAlreadyLinkedError struct {
+						Message string
+					} `graphql:"... on VariantAlreadyLinkedError"`
+				} `graphql:"linkPersistedQueryList(persistedQueryListId: $listId)"`
+			} `graphql:"variant(name: $variantName)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"variantName": graphql.String(variantName),
+		"listId":      graphql.ID(listId),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	result := mutation.Graph.Variant.LinkPersistedQueryList
+	if result.Typename != "LinkPersistedQueryListResult" {
+		return resultError(result.Typename, result.PermissionError.Message, result.VariantAlreadyLinkedError.Message)
+	}
+	return nil
+}
+
+func (c *ApolloClient) UnlinkPersistedQueryList(ctx context.Context, graphId string, variantName string) error {
+	var mutation struct {
+		Graph struct {
+			Variant struct {
+				UnlinkPersistedQueryList struct {
+					Typename        string `graphql:"__typename"`
+					PermissionError struct {
+						Message string
+					} `graphql:"... on PermissionError"`
+				}
+			} `graphql:"variant(name: $variantName)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"variantName": graphql.String(variantName),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	result := mutation.Graph.Variant.UnlinkPersistedQueryList
+	if result.Typename != "UnlinkPersistedQueryListResult" {
+		return resultError(result.Typename, result.PermissionError.Message)
+	}
+	return nil
+}
+
+// PublishPersistedQueries adds or updates the given operations and removes the operations with the given IDs
+// from a persisted query list, then returns the resulting build.
+func (c *ApolloClient) PublishPersistedQueries(ctx context.Context, graphId string, listId string, operations []PersistedQueryInput, removedOperationIds []string) (PersistedQueryListBuild, error) {
+	var mutation struct {
+		Graph struct {
+			PersistedQueryList struct {
+				PublishOperations struct {
+					Typename                string `graphql:"__typename"`
+					PublishOperationsResult struct {
+						Build PersistedQueryListBuild
+					} `graphql:"... on PublishOperationsResult"`
+					PermissionError struct {
+						Message string
+					} `graphql:"... on PermissionError"`
+					CannotModifyOperationBodyError struct {
+						Message string
+					} `graphql:"... on CannotModifyOperationBodyError"`
+				} `graphql:"publishOperations(operations: $operations, removeOperations: $removeOperations)"`
+			} `graphql:"persistedQueryList(id: $listId)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	// Nil slices would be sent as null, which the API rejects
+	if operations == nil {
+		operations = make([]PersistedQueryInput, 0)
+	}
+	removeOperations := make([]graphql.ID, 0, len(removedOperationIds))
+	for _, id := range removedOperationIds {
+		removeOperations = append(removeOperations, graphql.ID(id))
+	}
+	vars := map[string]interface{}{
+		"graphId":          graphql.ID(graphId),
+		"listId":           graphql.ID(listId),
+		"operations":       operations,
+		"removeOperations": removeOperations,
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return PersistedQueryListBuild{}, err
+	}
+	result := mutation.Graph.PersistedQueryList.PublishOperations
+	if result.Typename != "PublishOperationsResult" {
+		return PersistedQueryListBuild{}, resultError(result.Typename, result.PermissionError.Message, result.CannotModifyOperationBodyError.Message)
+	}
+	return result.PublishOperationsResult.Build, nil
+}
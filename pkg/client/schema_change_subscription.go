@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type RegistrySubscription struct {
+	Id       string
+	Variant  string
+	Enabled  bool
+	Events   []RegistrySubscriptionEvent
+	Channels []struct {
+		Id string
+	}
+}
+
+func (c *ApolloClient) GetSchemaChangeSubscriptions(ctx context.Context, graphId string) ([]RegistrySubscription, error) {
+	var query struct {
+		Graph struct {
+			RegistrySubscriptions []RegistrySubscription
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return query.Graph.RegistrySubscriptions, nil
+}
+
+// GetSchemaChangeSubscription returns a subscription of a graph, an empty id means the subscription doesn't exist.
+func (c *ApolloClient) GetSchemaChangeSubscription(ctx context.Context, graphId string, subscriptionId string) (RegistrySubscription, error) {
+	subscriptions, err := c.GetSchemaChangeSubscriptions(ctx, graphId)
+	if err != nil {
+		return RegistrySubscription{}, err
+	}
+	for _, subscription := range subscriptions {
+		if subscription.Id == subscriptionId {
+			return subscription, nil
+		}
+	}
+	return RegistrySubscription{}, nil
+}
+
+// UpsertSchemaChangeSubscription creates a subscription when subscriptionId is empty, otherwise it updates the existing one.
+func (c *ApolloClient) UpsertSchemaChangeSubscription(ctx context.Context, graphId string, subscriptionId string, variantName string, channelIds []string, events []RegistrySubscriptionEvent, enabled bool) (RegistrySubscription, error) {
+	var mutation struct {
+		Graph struct {
+			UpsertRegistrySubscription RegistrySubscription `graphql:"upsertRegistrySubscription(id: $subscriptionId, variant: $variantName, channelIds: $channelIds, events: $events, enabled: $enabled)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	var id *graphql.ID
+	if subscriptionId != "" {
+		value := graphql.ID(subscriptionId)
+		id = &value
+	}
+	ids := make([]graphql.ID, 0, len(channelIds))
+	for _, channelId := range channelIds {
+		ids = append(ids, graphql.ID(channelId))
+	}
+	vars := map[string]interface{}{
+		"graphId":        graphql.ID(graphId),
+		"subscriptionId": id,
+		"variantName":    graphql.String(variantName),
+		"channelIds":     ids,
+		"events":         events,
+		"enabled":        graphql.Boolean(enabled),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return RegistrySubscription{}, err
+	}
+	return mutation.Graph.UpsertRegistrySubscription, nil
+}
+
+func (c *ApolloClient) DeleteSchemaChangeSubscription(ctx context.Context, graphId string, subscriptionId string) error {
+	var mutation struct {
+		Graph struct {
+			DeleteRegistrySubscription bool `graphql:"deleteRegistrySubscription(id: $subscriptionId)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":        graphql.ID(graphId),
+		"subscriptionId": graphql.ID(subscriptionId),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}
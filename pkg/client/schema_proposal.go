@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type ProposalReview struct {
+	Decision  ProposalReviewDecision
+	CreatedAt string
+	CreatedBy struct {
+		Name string
+	}
+}
+
+type ProposalReviewRequest struct {
+	Reviewer struct {
+		Name string
+	}
+}
+
+type Proposal struct {
+	Id            string
+	DisplayName   string
+	Description   string
+	Status        ProposalStatus
+	CreatedAt     string
+	SourceVariant struct {
+		Name string
+	}
+	BackingVariant struct {
+		Name      string
+		SubGraphs []SubGraph `graphql:"subgraphs"`
+	}
+	Reviews        []ProposalReview
+	ReviewRequests []ProposalReviewRequest
+}
+
+// proposalResult is the union returned by the proposal mutations.
+type proposalResult struct {
+	Typename        string `graphql:"__typename"`
+	PermissionError struct {
+		Message string
+	} `graphql:"... on PermissionError"`
+	ValidationError struct {
+		Message string
+	} `graphql:"... on ValidationError"`
+}
+
+// err returns an error unless the mutation returned the expected type.
+func (r proposalResult) err(expected string) error {
+	if r.Typename == expected {
+		return nil
+	}
+	return resultError(r.Typename, r.PermissionError.Message, r.ValidationError.Message)
+}
+
+// GetProposal returns a schema proposal, an empty id means the proposal doesn't exist.
+func (c *ApolloClient) GetProposal(ctx context.Context, proposalId string) (Proposal, error) {
+	var query struct {
+		Proposal Proposal `graphql:"proposal(id: $proposalId)"`
+	}
+	vars := map[string]interface{}{
+		"proposalId": graphql.ID(proposalId),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return Proposal{}, err
+	}
+	return query.Proposal, nil
+}
+
+// CreateProposal opens a schema proposal against a variant and returns the ID of the proposal.
+func (c *ApolloClient) CreateProposal(ctx context.Context, graphId string, sourceVariantName string, displayName string, description string) (string, error) {
+	var mutation struct {
+		Graph struct {
+			CreateProposal struct {
+				proposalResult
+				GraphVariant struct {
+					Proposal struct {
+						Id string
+					}
+				} `graphql:"... on GraphVariant"`
+			} `graphql:"createProposal(input: $input)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId": graphql.ID(graphId),
+		"input": CreateProposalInput{
+			SourceVariantName: sourceVariantName,
+			DisplayName:       displayName,
+			Description:       description,
+		},
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return "", err
+	}
+	result := mutation.Graph.CreateProposal
+	if err := result.err("GraphVariant"); err != nil {
+		return "", err
+	}
+	return result.GraphVariant.Proposal.Id, nil
+}
+
+func (c *ApolloClient) UpdateProposal(ctx context.Context, proposalId string, displayName string, description string) error {
+	var mutation struct {
+		Proposal struct {
+			UpdateTitle       proposalResult `graphql:"updateTitle(title: $title)"`
+			UpdateDescription proposalResult `graphql:"updateDescription(description: $description)"`
+		} `graphql:"proposal(id: $proposalId)"`
+	}
+	vars := map[string]interface{}{
+		"proposalId":  graphql.ID(proposalId),
+		"title":       graphql.String(displayName),
+		"description": graphql.String(description),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	if err := mutation.Proposal.UpdateTitle.err("Proposal"); err != nil {
+		return err
+	}
+	return mutation.Proposal.UpdateDescription.err("Proposal")
+}
+
+func (c *ApolloClient) UpdateProposalStatus(ctx context.Context, proposalId string, status ProposalStatus) error {
+	var mutation struct {
+		Proposal struct {
+			UpdateStatus proposalResult `graphql:"updateStatus(status: $status)"`
+		} `graphql:"proposal(id: $proposalId)"`
+	}
+	vars := map[string]interface{}{
+		"proposalId": graphql.ID(proposalId),
+		"status":     status,
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return mutation.Proposal.UpdateStatus.err("Proposal")
+}
+
+// PublishProposalSubgraphs publishes a revision of the proposal with the given subgraph schemas, keyed by subgraph name.
+func (c *ApolloClient) PublishProposalSubgraphs(ctx context.Context, proposalId string, summary string, subgraphs map[string]string) error {
+	var mutation struct {
+		Proposal struct {
+			PublishSubgraphs proposalResult `graphql:"publishSubgraphs(input: $input)"`
+		} `graphql:"proposal(id: $proposalId)"`
+	}
+	input := PublishProposalSubgraphsInput{
+		Summary:   summary,
+		Subgraphs: make([]PublishProposalSubgraphInput, 0, len(subgraphs)),
+	}
+	for name, sdl := range subgraphs {
+		input.Subgraphs = append(input.Subgraphs, PublishProposalSubgraphInput{Name: name, Sdl: sdl})
+	}
+	vars := map[string]interface{}{
+		"proposalId": graphql.ID(proposalId),
+		"input":      input,
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return mutation.Proposal.PublishSubgraphs.err("Proposal")
+}
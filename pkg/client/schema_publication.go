@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type SubgraphChange struct {
+	Name string
+	Type SubgraphChangeType
+}
+
+type SchemaPublication struct {
+	PublishedAt string
+	PublishedBy struct {
+		Name string
+	}
+	Schema struct {
+		Hash string
+	}
+	Launch struct {
+		Id              string
+		SubgraphChanges []SubgraphChange
+	}
+}
+
+type SchemaDiff struct {
+	Changes       []Change
+	ChangeSummary ChangeSummary
+}
+
+// GetSchemaPublications returns the most recent schema publications of a variant, newest first.
+func (c *ApolloClient) GetSchemaPublications(ctx context.Context, graphId string, variantName string, limit int) ([]SchemaPublication, error) {
+	var query struct {
+		Graph struct {
+			Variant struct {
+				LatestPublication struct {
+					History []SchemaPublication `graphql:"history(limit: $limit)"`
+				}
+			} `graphql:"variant(name: $variantName)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":     graphql.ID(graphId),
+		"variantName": graphql.String(variantName),
+		"limit":       graphql.Int(limit),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return query.Graph.Variant.LatestPublication.History, nil
+}
+
+// GetSchemaDiff returns the changes between two schemas of a graph, identified by their hashes.
+func (c *ApolloClient) GetSchemaDiff(ctx context.Context, graphId string, baseHash string, targetHash string) (SchemaDiff, error) {
+	var query struct {
+		Graph struct {
+			SchemaDiff SchemaDiff `graphql:"schemaDiff(baseSchemaHash: $baseHash, targetSchemaHash: $targetHash)"`
+		} `graphql:"graph(id: $graphId)"`
+	}
+	vars := map[string]interface{}{
+		"graphId":    graphql.ID(graphId),
+		"baseHash":   graphql.String(baseHash),
+		"targetHash": graphql.String(targetHash),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return SchemaDiff{}, err
+	}
+	return query.Graph.SchemaDiff, nil
+}
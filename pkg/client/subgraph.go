@@ -69,13 +69,14 @@ func (c *ApolloClient) GetSubGraphs(ctx context.Context, graphId string, variant
 	var query struct {
 		Graph struct {
 			Variant struct {
-				SubGraphs []SubGraph `graphql:"subgraphs"`
+				SubGraphs []SubGraph `graphql:"subgraphs(includeDeleted: $includeDeleted)"`
 			} `graphql:"variant(name: $variantName)"`
 		} `graphql:"graph(id: $graphId)"`
 	}
 	vars := map[string]interface{}{
-		"graphId":     graphql.ID(graphId),
-		"variantName": graphql.String(variantName),
+		"graphId":        graphql.ID(graphId),
+		"variantName":    graphql.String(variantName),
+		"includeDeleted": graphql.Boolean(includeDeleted),
 	}
 	err := c.gqlClient.Query(ctx, &query, vars)
 	if err != nil {
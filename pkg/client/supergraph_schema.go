@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+type SupergraphSchema struct {
+	VariantId      string
+	LaunchId       string
+	SupergraphSdl  string
+	SupergraphHash string
+	ApiSchemaSdl   string
+	ApiSchemaHash  string
+}
+
+// GetSupergraphSchema returns the supergraph and API schemas built by the latest approved launch of a variant.
+// Both schemas come from the same build so they always match. The API schema hash is computed the same way
+// Apollo hashes schema documents, as the build doesn't expose it.
+// An empty variant id means the variant doesn't exist.
+func (c *ApolloClient) GetSupergraphSchema(ctx context.Context, variantRef string) (SupergraphSchema, error) {
+	var query struct {
+		Variant struct {
+			GraphVariant struct {
+				Id                   string
+				LatestApprovedLaunch struct {
+					Id    string
+					Build struct {
+						Result struct {
+							BuildSuccess struct {
+								CoreSchema struct {
+									CoreDocument string
+									CoreHash     string
+									ApiDocument  string
+								}
+							} `graphql:"... on BuildSuccess"`
+						}
+					}
+				}
+			} `graphql:"... on GraphVariant"`
+		} `graphql:"variant(ref: $ref)"`
+	}
+	vars := map[string]interface{}{
+		"ref": graphql.ID(variantRef),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return SupergraphSchema{}, err
+	}
+	variant := query.Variant.GraphVariant
+	coreSchema := variant.LatestApprovedLaunch.Build.Result.BuildSuccess.CoreSchema
+	apiSchemaHash := sha256.Sum256([]byte(coreSchema.ApiDocument))
+	return SupergraphSchema{
+		VariantId:      variant.Id,
+		LaunchId:       variant.LatestApprovedLaunch.Id,
+		SupergraphSdl:  coreSchema.CoreDocument,
+		SupergraphHash: coreSchema.CoreHash,
+		ApiSchemaSdl:   coreSchema.ApiDocument,
+		ApiSchemaHash:  hex.EncodeToString(apiSchemaHash[:]),
+	}, nil
+}
@@ -1,5 +1,9 @@
 package client
 
+import (
+	"strings"
+)
+
 type HistoricQueryParametersInput struct{}
 
 type GitContextInput struct {
@@ -19,6 +23,75 @@ type SubgraphCheckAsyncInput struct {
 	SubgraphName   string                       `json:"subgraphName"`
 }
 
+type CheckSchemaAsyncInput struct {
+	Config                 HistoricQueryParametersInput `json:"config"`
+	GitContext             GitContextInput              `json:"gitContext"`
+	IsSandbox              bool                         `json:"isSandbox"`
+	ProposedSchemaDocument string                       `json:"proposedSchemaDocument"`
+}
+
+type LinterRuleLevelConfigurationChangesInput struct {
+	Rule  LintRule            `json:"rule"`
+	Level LintDiagnosticLevel `json:"level"`
+}
+
+type GraphLinterConfigurationChangesInput struct {
+	IgnoreExistingViolations *bool                                      `json:"ignoreExistingViolations,omitempty"`
+	IgnoredDirectives        []string                                   `json:"ignoredDirectives"`
+	Rules                    []LinterRuleLevelConfigurationChangesInput `json:"rules"`
+}
+
+type ClientFilterInput struct {
+	Name    string  `json:"name"`
+	Version *string `json:"version"`
+}
+
+// NewClientFilterInput creates a client filter, an empty version matches every version of the client.
+func NewClientFilterInput(name string, version string) ClientFilterInput {
+	clientFilter := ClientFilterInput{Name: name}
+	if version != "" {
+		clientFilter.Version = &version
+	}
+	return clientFilter
+}
+
+type ExcludedOperationInput struct {
+	Id string `json:"ID"`
+}
+
+type OperationNameFilterInput struct {
+	Name string `json:"name"`
+}
+
+type CreateProposalInput struct {
+	SourceVariantName string `json:"sourceVariantName"`
+	DisplayName       string `json:"displayName"`
+	Description       string `json:"description"`
+}
+
+type PublishProposalSubgraphInput struct {
+	Name string `json:"name"`
+	Sdl  string `json:"sdl"`
+}
+
+type PublishProposalSubgraphsInput struct {
+	Summary   string                         `json:"summary"`
+	Subgraphs []PublishProposalSubgraphInput `json:"subgraphInputs"`
+}
+
+type QueryTriggerInput struct {
+	Variant                *string                   `json:"variant"`
+	Metric                 QueryTriggerMetric        `json:"metric"`
+	Threshold              float64                   `json:"threshold"`
+	ThresholdType          QueryTriggerThresholdType `json:"thresholdType"`
+	Window                 QueryTriggerWindow        `json:"window"`
+	Scope                  QueryTriggerScope         `json:"scope"`
+	OperationNames         []string                  `json:"operationNames"`
+	ExcludedOperationNames []string                  `json:"excludedOperationNames"`
+	ChannelIds             []string                  `json:"channelIds"`
+	Enabled                bool                      `json:"enabled"`
+}
+
 type FieldChangeSummaryCounts struct {
 	Additions int `json:"additions"`
 	Removals  int `json:"removals"`
@@ -142,6 +215,9 @@ const (
 
 type LintDiagnosticLevel string
 
+// LintRule is the name of a schema linter rule (e.g. `FIELD_NAMES_SHOULD_BE_CAMEL_CASE`).
+type LintRule string
+
 const (
 	CategoryAddition    ChangeCategory = "ADDITION"
 	CatergoryRemoval    ChangeCategory = "REMOVAL"
@@ -151,6 +227,14 @@ const (
 
 type ChangeCategory string
 
+const (
+	SubgraphChangeTypeAddition     SubgraphChangeType = "ADDITION"
+	SubgraphChangeTypeRemoval      SubgraphChangeType = "REMOVAL"
+	SubgraphChangeTypeModification SubgraphChangeType = "MODIFICATION"
+)
+
+type SubgraphChangeType string
+
 const (
 	TaskTypeOperationsCheck  TaskTypename = "OperationsCheckTask"
 	TaskTypeCompositionCheck TaskTypename = "CompositionCheckTask"
@@ -162,6 +246,142 @@ const (
 
 type TaskTypename string
 
+const (
+	LaunchStatusInitiated LaunchStatus = "LAUNCH_INITIATED"
+	LaunchStatusCompleted LaunchStatus = "LAUNCH_COMPLETED"
+	LaunchStatusFailed    LaunchStatus = "LAUNCH_FAILED"
+)
+
+type LaunchStatus string
+
+const (
+	UserPermissionGraphAdmin  UserPermission = "GRAPH_ADMIN"
+	UserPermissionContributor UserPermission = "CONTRIBUTOR"
+	UserPermissionDocumenter  UserPermission = "DOCUMENTER"
+	UserPermissionObserver    UserPermission = "OBSERVER"
+	UserPermissionConsumer    UserPermission = "CONSUMER"
+
+	UserPermissionOrgAdmin       UserPermission = "ORG_ADMIN"
+	UserPermissionBillingManager UserPermission = "BILLING_MANAGER"
+)
+
+// UserPermission is the role granted to an API key or to a member of the organization.
+// The organization-wide roles can't be granted to graph API keys.
+type UserPermission string
+
+const (
+	GraphTypeClassic              GraphType = "CLASSIC"
+	GraphTypeCloudSupergraph      GraphType = "CLOUD_SUPERGRAPH"
+	GraphTypeSelfHostedSupergraph GraphType = "SELF_HOSTED_SUPERGRAPH"
+)
+
+const (
+	ProposalChangeMismatchSeverityOff   ProposalChangeMismatchSeverity = "OFF"
+	ProposalChangeMismatchSeverityWarn  ProposalChangeMismatchSeverity = "WARN"
+	ProposalChangeMismatchSeverityError ProposalChangeMismatchSeverity = "ERROR"
+)
+
+// ProposalChangeMismatchSeverity is the outcome of a check when the changes don't match an approved proposal.
+type ProposalChangeMismatchSeverity string
+
+const (
+	ProposalStatusDraft       ProposalStatus = "DRAFT"
+	ProposalStatusOpen        ProposalStatus = "OPEN"
+	ProposalStatusApproved    ProposalStatus = "APPROVED"
+	ProposalStatusImplemented ProposalStatus = "IMPLEMENTED"
+	ProposalStatusClosed      ProposalStatus = "CLOSED"
+)
+
+type ProposalStatus string
+
+const (
+	ProposalReviewDecisionApproved         ProposalReviewDecision = "APPROVED"
+	ProposalReviewDecisionChangesRequested ProposalReviewDecision = "CHANGES_REQUESTED"
+	ProposalReviewDecisionNotApplicable    ProposalReviewDecision = "NOT_APPLICABLE"
+)
+
+type ProposalReviewDecision string
+
+const (
+	NotificationChannelTypeWebhook NotificationChannelType = "WEBHOOK"
+	NotificationChannelTypeSlack   NotificationChannelType = "SLACK"
+	NotificationChannelTypeEmail   NotificationChannelType = "EMAIL"
+)
+
+type NotificationChannelType string
+
+const (
+	RegistrySubscriptionEventSchemaPublish RegistrySubscriptionEvent = "SCHEMA_PUBLISH"
+	RegistrySubscriptionEventBuildError    RegistrySubscriptionEvent = "BUILD_ERROR"
+	RegistrySubscriptionEventLaunchFailure RegistrySubscriptionEvent = "LAUNCH_FAILURE"
+)
+
+// RegistrySubscriptionEvent is an event of a variant sent to the channels of a schema change subscription.
+type RegistrySubscriptionEvent string
+
+const (
+	QueryTriggerMetricRequestsPerMinute   QueryTriggerMetric = "REQUESTS_PER_MINUTE"
+	QueryTriggerMetricErrorCountPerMinute QueryTriggerMetric = "ERROR_COUNT_PER_MINUTE"
+	QueryTriggerMetricErrorPercentage     QueryTriggerMetric = "ERROR_PERCENTAGE"
+	QueryTriggerMetricP50Duration         QueryTriggerMetric = "P50_DURATION"
+	QueryTriggerMetricP95Duration         QueryTriggerMetric = "P95_DURATION"
+	QueryTriggerMetricP99Duration         QueryTriggerMetric = "P99_DURATION"
+)
+
+// QueryTriggerMetric is the metric watched by a performance alert.
+type QueryTriggerMetric string
+
+const (
+	QueryTriggerThresholdTypeAboveOrEqual QueryTriggerThresholdType = "ABOVE_OR_EQUAL"
+	QueryTriggerThresholdTypeBelowOrEqual QueryTriggerThresholdType = "BELOW_OR_EQUAL"
+)
+
+type QueryTriggerThresholdType string
+
+const (
+	QueryTriggerWindowOneMinute      QueryTriggerWindow = "ONE_MINUTE"
+	QueryTriggerWindowFiveMinutes    QueryTriggerWindow = "FIVE_MINUTES"
+	QueryTriggerWindowFifteenMinutes QueryTriggerWindow = "FIFTEEN_MINUTES"
+)
+
+type QueryTriggerWindow string
+
+const (
+	QueryTriggerScopeAll      QueryTriggerScope = "ALL"
+	QueryTriggerScopeAny      QueryTriggerScope = "ANY"
+	QueryTriggerScopeIncluded QueryTriggerScope = "INCLUDED"
+	QueryTriggerScopeExcluded QueryTriggerScope = "EXCLUDED"
+)
+
+// QueryTriggerScope is how the operations of a performance alert are selected, `ALL` being the whole traffic and `ANY` any single operation.
+type QueryTriggerScope string
+
+// Long is the GraphQL scalar used for 64-bit integers.
+type Long int64
+
+// Timestamp is the GraphQL scalar used for dates, formatted as RFC 3339.
+type Timestamp string
+
+const (
+	ResolutionR1D Resolution = "R1D"
+)
+
+// Resolution is the period statistics are rolled up over, `R1D` being a day.
+type Resolution string
+
+// FieldUsageFilter restricts the field usage statistics, schemaTag being the name of the variant.
+type FieldUsageFilter struct {
+	SchemaTag string `json:"schemaTag"`
+}
+
+// QueryStatsFilter restricts the operation statistics, schemaTag being the name of the variant.
+type QueryStatsFilter struct {
+	SchemaTag string `json:"schemaTag"`
+}
+
+// GraphType is the kind of graph, `CLASSIC` being a non-federated (monolith) graph.
+type GraphType string
+
 type OperationsCheckTask struct {
 	// Id string `json:"id"`
 	Result OperationsCheckResult   `json:"result"`
@@ -190,3 +410,20 @@ type ProposalsCheckTask struct {
 type FilterCheckTask struct {
 	Status CheckWorkflowTaskStatus `json:"status"`
 }
+
+// BuildPipelineTrack is the federation version a variant is composed with (e.g. `FED_2_3`).
+type BuildPipelineTrack string
+
+// NewBuildPipelineTrack converts a federation version (e.g. `2.3`) to its build pipeline track (e.g. `FED_2_3`).
+func NewBuildPipelineTrack(federationVersion string) BuildPipelineTrack {
+	return BuildPipelineTrack("FED_" + strings.ReplaceAll(NormalizeFederationVersion(federationVersion), ".", "_"))
+}
+
+// NormalizeFederationVersion strips the patch part of a federation version, the API may return `2.3.2` for a `FED_2_3` track.
+func NormalizeFederationVersion(federationVersion string) string {
+	parts := strings.Split(strings.TrimPrefix(federationVersion, "v"), ".")
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+	return strings.Join(parts, ".")
+}
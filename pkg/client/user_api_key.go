@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+// UserApiKey is a personal API key, it has the permissions of the user who owns it.
+type UserApiKey struct {
+	Id        string
+	KeyName   string
+	Token     string
+	CreatedAt string
+}
+
+// GetCurrentUserId returns the ID of the user owning the API key used by the client.
+// It fails when the client authenticates with a graph or an organization API key.
+func (c *ApolloClient) GetCurrentUserId(ctx context.Context) (string, error) {
+	var query struct {
+		Me struct {
+			Typename string `graphql:"__typename"`
+			Id       string
+		}
+	}
+	err := c.gqlClient.Query(ctx, &query, nil)
+	if err != nil {
+		return "", err
+	}
+	if query.Me.Typename != "User" {
+		return "", fmt.Errorf("the API key is owned by a %s, user API keys can only be managed with a personal API key", query.Me.Typename)
+	}
+	return query.Me.Id, nil
+}
+
+func (c *ApolloClient) GetUserApiKeys(ctx context.Context, userId string) ([]UserApiKey, error) {
+	var query struct {
+		User struct {
+			ApiKeys []UserApiKey
+		} `graphql:"user(id: $userId)"`
+	}
+	vars := map[string]interface{}{
+		"userId": graphql.ID(userId),
+	}
+	err := c.gqlClient.Query(ctx, &query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return query.User.ApiKeys, nil
+}
+
+func (c *ApolloClient) GetUserApiKey(ctx context.Context, userId string, apiKeyId string) (UserApiKey, error) {
+	apiKeys, err := c.GetUserApiKeys(ctx, userId)
+	if err != nil {
+		return UserApiKey{}, err
+	}
+	for _, ak := range apiKeys {
+		if ak.Id == apiKeyId {
+			return ak, nil
+		}
+	}
+	return UserApiKey{}, nil
+}
+
+func (c *ApolloClient) CreateUserApiKey(ctx context.Context, userId string, keyName string) (UserApiKey, error) {
+	var mutation struct {
+		User struct {
+			NewKey UserApiKey `graphql:"newKey(keyName: $keyName)"`
+		} `graphql:"user(id: $userId)"`
+	}
+	vars := map[string]interface{}{
+		"userId":  graphql.ID(userId),
+		"keyName": graphql.String(keyName),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return UserApiKey{}, err
+	}
+	return mutation.User.NewKey, nil
+}
+
+func (c *ApolloClient) RenameUserApiKey(ctx context.Context, userId string, apiKeyId string, newKeyName string) error {
+	var mutation struct {
+		User struct {
+			RenameKey struct {
+				Id      string
+				KeyName string
+			} `graphql:"renameKey(id: $apiKeyId, newKeyName: $keyName)"`
+		} `graphql:"user(id: $userId)"`
+	}
+	vars := map[string]interface{}{
+		"userId":   graphql.ID(userId),
+		"apiKeyId": graphql.ID(apiKeyId),
+		"keyName":  graphql.String(newKeyName),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *ApolloClient) RemoveUserApiKey(ctx context.Context, userId string, apiKeyId string) error {
+	var mutation struct {
+		User struct {
+			RemoveKey string `graphql:"removeKey(id: $apiKeyId)"`
+		} `graphql:"user(id: $userId)"`
+	}
+	vars := map[string]interface{}{
+		"userId":   graphql.ID(userId),
+		"apiKeyId": graphql.ID(apiKeyId),
+	}
+	err := c.gqlClient.Mutate(ctx, &mutation, vars)
+	if err != nil {
+		return err
+	}
+	return nil
+}